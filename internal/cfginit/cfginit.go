@@ -48,7 +48,8 @@ protoc:
 
 
   # If not set, compile will fail if there are unused imports.
-  # Setting this will ignore unused imports.
+  # Setting this will ignore unused imports, and format --fix
+  # will not delete them.
   {{.V}}allow_unused_imports: true
 
 # Create directives.
@@ -73,6 +74,25 @@ protoc:
   # Setting this value will result in lint.rules.no_default being ignored.
 {{.V}}  group: uber2
 
+  # User-defined lint groups that can be selected with lint.group.
+  # Each group extends a built-in group or another user-defined group,
+  # adding and removing specific linters. If extends is not set, the
+  # group starts with no linters.
+{{.V}}  groups:
+{{.V}}    - name: acme
+{{.V}}      extends: uber2
+{{.V}}      add:
+{{.V}}        - ENUM_NAMES_CAMEL_CASE
+{{.V}}      remove:
+{{.V}}        - FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME
+
+  # Paths to files that define additional lint groups, in the same format
+  # as lint.groups under a top-level groups key. This allows multiple
+  # repositories to share the same lint groups. Relative paths are
+  # relative to the directory of this configuration file.
+{{.V}}  group_files:
+{{.V}}    - path/to/lint_groups.yaml
+
   # Linter files to ignore.
 {{.V}}  ignores:
 {{.V}}    - id: RPC_NAMES_CAMEL_CASE
@@ -101,6 +121,78 @@ protoc:
 {{.V}}    remove:
 {{.V}}      - ENUM_NAMES_CAMEL_CASE
 
+  # Severity overrides for specific linters.
+  # Valid severities are error, warning, and info. All linters have the
+  # error severity by default. Only failures with a severity greater than
+  # or equal to the --fail-on flag, which defaults to error, will result
+  # in a non-zero exit code.
+{{.V}}  severities:
+{{.V}}    FILE_OPTIONS_REQUIRE_GO_PACKAGE: warning
+
+  # Structural limits checked by the FILE_MAX_IMPORTS, FILE_MAX_LINES,
+  # MESSAGE_MAX_DEPTH, MESSAGE_MAX_FIELDS and SERVICE_MAX_RPCS linters.
+  # These linters must be added to be run. Unset limits are not checked.
+{{.V}}  limits:
+{{.V}}    max_message_fields: 50
+    # Top-level messages have a depth of 1.
+{{.V}}    max_message_depth: 3
+{{.V}}    max_service_rpcs: 20
+{{.V}}    max_file_lines: 1000
+{{.V}}    max_file_imports: 20
+
+  # Rules for the imports of the files in specific directories, checked
+  # by the IMPORTS_ALLOWED and IMPORTS_NOT_DENIED linters. These linters
+  # must be added to be run. Directories and imports are matched against
+  # slash-separated glob patterns where "**" matches any number of
+  # directories. Directories are relative to this file. If allow is set,
+  # imports must match one of its patterns. Imports must not match any
+  # of the patterns in deny.
+{{.V}}  imports:
+{{.V}}    - dir: public/**
+{{.V}}      allow:
+{{.V}}        - public/**
+{{.V}}        - google/protobuf/*.proto
+{{.V}}      deny:
+{{.V}}        - internal/**
+
+  # The maximum time that an element can be deprecated for before it
+  # should be deleted, checked with git blame by the DEPRECATIONS_NOT_EXPIRED
+  # linter. This linter must be added to be run, and has the warning
+  # severity by default. Either a number of days such as 180d, or a
+  # duration such as 720h.
+{{.V}}  deprecations:
+{{.V}}    max_age: 180d
+
+  # The canonical types of fields by name, checked by the
+  # MESSAGE_FIELDS_CONSISTENT linter. This linter must be added to be run.
+  # All fields with the name must have the type, which is prefixed with
+  # repeated for repeated fields.
+{{.V}}  canonical_fields:
+{{.V}}    - name: create_time
+{{.V}}      type: google.protobuf.Timestamp
+
+  # The path to the project dictionary for the COMMENTS_SPELLED_CORRECTLY
+  # and NAMES_SPELLED_CORRECTLY linters, relative to this file. These
+  # linters must be added to be run. The dictionary has one word per line
+  # and lines that begin with # are ignored. The words are accepted in
+  # addition to the bundled English wordlist.
+{{.V}}  dictionary:
+{{.V}}    path: path/to/dictionary.txt
+
+  # The fully-qualified names of the messages that can declare extension
+  # ranges or be extended, checked by the EXTENSIONS_ALLOWED linter. This
+  # linter must be added to be run.
+{{.V}}  extensions:
+{{.V}}    allow:
+{{.V}}      - google.protobuf.FieldOptions
+
+  # The fully-qualified names of the messages whose fields can be optional
+  # in proto3 files, checked by the FIELDS_NOT_PROTO3_OPTIONAL linter. This
+  # linter must be added to be run.
+{{.V}}  proto3_optional:
+{{.V}}    allow:
+{{.V}}      - foo.v1.FooPatch
+
   # The path to the file header for all Protobuf files.
   # If this is set and the FILE_HEADER linter is turned on, files will
   # be checked to begin with the contents of this file, and format --fix
@@ -115,6 +207,39 @@ protoc:
 {{.V}}    path: path/to/protobuf_file_header.txt
 {{.V}}    is_commented: true
 
+# Format directives.
+{{.V}}format:
+  # The maximum line length for format.
+  # Field options and RPC signatures that would exceed this length are
+  # broken across multiple lines. Comments are not counted, and lines
+  # that cannot be broken are left as is.
+  # The default is no maximum line length.
+{{.V}}  max_line_length: 120
+  # The number of spaces per indent level.
+  # If use_tabs is set, this is the width of a tab for max_line_length.
+  # The default is 2.
+{{.V}}  indent_width: 4
+  # Indent with tabs instead of spaces.
+{{.V}}  use_tabs: false
+  # Align the names and numbers of consecutive message fields in columns.
+{{.V}}  align_fields: true
+  # Align the numbers of consecutive enum values in a column.
+{{.V}}  align_enum_values: true
+  # The number of blank lines between top-level elements.
+  # The default is 1.
+{{.V}}  top_level_blank_lines: 1
+  # The maximum line length that the paragraphs of // comments are reflowed to.
+  # Blank lines, list items, code indented by more than one space and
+  # fenced code blocks are preserved, and inline comments are not reflowed.
+  # The default is to not reflow comments.
+{{.V}}  comment_width: 100
+  # When running format with --fix, order top-level elements so that services
+  # come first, followed by the request and response types of each RPC in the
+  # order of the RPCs, and then all other messages and enums. Options in
+  # message, enum and service bodies are moved to the start of the body and
+  # sorted by name.
+{{.V}}  canonical_order: true
+
 # Code generation directives.
 {{.V}}generate:
   # Options that will apply to all plugins of type go and gogo.
@@ -109,6 +109,7 @@ func getRootCommand(develMode bool, exitCodeAddr *int, args []string, stdin io.R
 	configCmd.AddCommand(configInitCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(lintCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
+	rootCmd.AddCommand(mvCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
 	rootCmd.AddCommand(versionCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
 	cacheCmd := &cobra.Command{Use: "cache", Short: "Interact with the cache."}
 	cacheCmd.AddCommand(cacheUpdateCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
@@ -122,6 +123,9 @@ func getRootCommand(develMode bool, exitCodeAddr *int, args []string, stdin io.R
 	breakCmd := &cobra.Command{Use: "break", Short: "Top-level command for breaking change commands."}
 	breakCmd.AddCommand(breakCheckCmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
 	rootCmd.AddCommand(breakCmd)
+	migrateCmd := &cobra.Command{Use: "migrate", Short: "Top-level command for migration commands."}
+	migrateCmd.AddCommand(migrateProto3CmdTemplate.Build(develMode, exitCodeAddr, stdin, stdout, stderr, flags))
+	rootCmd.AddCommand(migrateCmd)
 
 	// flags bound to rootCmd are global flags
 	flags.bindDebug(rootCmd.PersistentFlags())
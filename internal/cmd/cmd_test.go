@@ -23,6 +23,7 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -393,6 +394,51 @@ func TestLint(t *testing.T) {
 		"testdata/lint/noreserved/foo.proto",
 	)
 
+	assertDoLintFile(
+		t,
+		false,
+		`23:1:MESSAGE_FIELD_NUMBERS_NO_GAPS
+		23:1:RESERVED_NUMBERS_HAVE_NAMES
+		28:3:MESSAGE_FIELDS_IN_NUMBER_ORDER
+		29:3:MESSAGE_FIELD_NUMBERS_NOT_IMPLEMENTATION_RESERVED
+		30:3:MESSAGE_FIELD_NUMBERS_NO_GAPS
+		42:1:ENUM_VALUES_CONTIGUOUS
+		42:1:RESERVED_NUMBERS_HAVE_NAMES`,
+		"testdata/lint/numbering/foo.proto",
+	)
+
+	assertDoLintFile(
+		t,
+		false,
+		`35:3:STANDARD_METHOD_TYPES
+		35:3:STANDARD_METHOD_TYPES
+		36:5:RPC_OPTIONS_GOOGLE_API_HTTP_MATCH_METHOD
+		42:5:RPC_OPTIONS_GOOGLE_API_HTTP_MATCH_METHOD
+		45:5:RPC_OPTIONS_GOOGLE_API_HTTP_MATCH_METHOD
+		50:3:STANDARD_METHOD_TYPES
+		99:1:LIST_REQUESTS_PAGINATED
+		99:1:LIST_REQUESTS_PAGINATED
+		103:1:LIST_RESPONSES_NEXT_PAGE_TOKEN
+		107:1:UPDATE_REQUESTS_UPDATE_MASK`,
+		"testdata/lint/aip/library.proto",
+	)
+
+	assertDoLintFile(
+		t,
+		false,
+		`11:3:STANDARD_METHOD_TYPES
+		26:1:LIST_REQUESTS_PAGINATED`,
+		"testdata/lint/aipqualified/library.proto",
+	)
+	assertDoLintFiles(
+		t,
+		false,
+		`testdata/lint/aipsplit/library.proto:13:1:LIST_REQUESTS_PAGINATED
+		testdata/lint/aipsplit/library.proto:18:1:LIST_RESPONSES_NEXT_PAGE_TOKEN
+		testdata/lint/aipsplit/library.proto:22:1:UPDATE_REQUESTS_UPDATE_MASK`,
+		"testdata/lint/aipsplit",
+	)
+
 	assertDoLintFile(
 		t,
 		false,
@@ -672,6 +718,439 @@ func TestLint(t *testing.T) {
 	)
 }
 
+func TestLintLimits(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		false,
+		`1:1:FILE_MAX_LINES
+		6:1:FILE_MAX_IMPORTS
+		13:1:MESSAGE_MAX_FIELDS
+		22:5:MESSAGE_MAX_DEPTH
+		34:1:SERVICE_MAX_RPCS`,
+		"testdata/lint/limits/foo.proto",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		"lint limit max_file_lines must not be negative: -1",
+		"lint", "testdata/lint/limits/foo.proto",
+		"--config-data", `{"lint":{"limits":{"max_file_lines":-1}}}`,
+	)
+}
+
+func TestLintImports(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		false,
+		`6:1:IMPORTS_ALLOWED
+		6:1:IMPORTS_NOT_DENIED
+		7:1:IMPORTS_ALLOWED`,
+		"testdata/lint/imports/public/v1/foo.proto",
+	)
+	assertDoLintFile(
+		t,
+		true,
+		``,
+		"testdata/lint/imports/internal/v1/bar.proto",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`invalid pattern "foo/[" for lint import rule for dir foo/[: syntax error in pattern`,
+		"lint", "testdata/lint/imports",
+		"--config-data", `{"lint":{"imports":[{"dir":"foo/[","deny":["bar/**"]}]}}`,
+	)
+}
+
+func TestLintFieldsConsistent(t *testing.T) {
+	t.Parallel()
+	assertDoLintFiles(
+		t,
+		false,
+		`testdata/lint/fieldsconsistent/bar/v1/bar.proto:12:3:MESSAGE_FIELDS_CONSISTENT
+		testdata/lint/fieldsconsistent/baz/v1/baz.proto:8:3:MESSAGE_FIELDS_CONSISTENT
+		testdata/lint/fieldsconsistent/baz/v1/baz.proto:9:3:MESSAGE_FIELDS_CONSISTENT`,
+		"testdata/lint/fieldsconsistent",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`duplicate canonical field create_time`,
+		"lint", "testdata/lint/fieldsconsistent",
+		"--config-data", `{"lint":{"canonical_fields":[{"name":"create_time","type":"int64"},{"name":"create_time","type":"string"}]}}`,
+	)
+}
+
+func TestLintSpelling(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		false,
+		`7:3:COMMENTS_SPELLED_CORRECTLY
+		7:3:COMMENTS_SPELLED_CORRECTLY
+		8:3:NAMES_SPELLED_CORRECTLY
+		9:3:COMMENTS_NO_TODOS
+		10:20:COMMENTS_NO_TODOS
+		11:3:COMMENTS_NOT_RESTATING_NAMES
+		18:3:COMMENTS_SPELLED_CORRECTLY
+		22:1:COMMENTS_NOT_RESTATING_NAMES
+		24:3:COMMENTS_NOT_RESTATING_NAMES`,
+		"testdata/lint/spelling/foo.proto",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`path for dictionary must be relative: /dictionary.txt`,
+		"lint", "testdata/lint/spelling",
+		"--config-data", `{"lint":{"dictionary":{"path":"/dictionary.txt"}}}`,
+	)
+}
+
+func TestLintLegacy(t *testing.T) {
+	t.Parallel()
+	assertDoLintFiles(
+		t,
+		false,
+		`testdata/lint/legacy/bar.proto:10:12:FIELDS_NOT_PROTO3_OPTIONAL
+		testdata/lint/legacy/foo.proto:12:3:EXTENSIONS_ALLOWED
+		testdata/lint/legacy/foo.proto:13:12:FIELDS_NOT_REQUIRED
+		testdata/lint/legacy/foo.proto:14:27:FIELDS_NO_DEFAULT_VALUES
+		testdata/lint/legacy/foo.proto:15:12:MESSAGES_NO_GROUPS
+		testdata/lint/legacy/foo.proto:16:14:FIELDS_NOT_REQUIRED
+		testdata/lint/legacy/foo.proto:29:1:EXTENSIONS_ALLOWED
+		testdata/lint/legacy/foo.proto:37:1:EXTENSIONS_ALLOWED
+		testdata/lint/legacy/foo.proto:42:3:ENUMS_NO_ALLOW_ALIAS`,
+		"testdata/lint/legacy",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`message name for lint.extensions.allow is empty`,
+		"lint", "testdata/lint/legacy",
+		"--config-data", `{"lint":{"extensions":{"allow":["."]}}}`,
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`duplicate message name for lint.proto3_optional.allow: foo.Patch`,
+		"lint", "testdata/lint/legacy",
+		"--config-data", `{"lint":{"proto3_optional":{"allow":["foo.Patch", ".foo.Patch"]}}}`,
+	)
+}
+
+func TestLintCache(t *testing.T) {
+	t.Parallel()
+	// the second run uses the cached failures for all files
+	for i := 0; i < 2; i++ {
+		assertDoLintFiles(
+			t,
+			false,
+			`testdata/lint/fieldsconsistent/bar/v1/bar.proto:12:3:MESSAGE_FIELDS_CONSISTENT
+			testdata/lint/fieldsconsistent/baz/v1/baz.proto:8:3:MESSAGE_FIELDS_CONSISTENT
+			testdata/lint/fieldsconsistent/baz/v1/baz.proto:9:3:MESSAGE_FIELDS_CONSISTENT`,
+			"testdata/lint/fieldsconsistent", "--cache",
+		)
+	}
+	assertExact(t, false, 255, "can only set one of cache, fix", "lint", "--cache", "--fix", "testdata/lint/fieldsconsistent")
+	// a change to a file imported from outside of the linted files is not
+	// hidden by the cached failures
+	tmpDir := copyTestdataDir(t, "testdata/lint/cacheimports")
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	assertDo(t, false, 0, "", "lint", "--cache", filepath.Join(tmpDir, "proto"))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "external", "bar", "v1", "bar.proto"), []byte(`syntax = "proto3";
+
+package bar.v1;
+
+message Baz {}
+`), 0644))
+	_, exitCode := testDo(t, false, "lint", "--cache", filepath.Join(tmpDir, "proto"))
+	assert.Equal(t, 255, exitCode)
+}
+
+func TestLintDeprecations(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		false,
+		`12:3:DEPRECATIONS_HAVE_COMMENTS
+		15:3:DEPRECATED_MESSAGES_NOT_REFERENCED
+		18:3:DEPRECATED_MESSAGES_NOT_REFERENCED
+		19:3:DEPRECATED_MESSAGES_NOT_REFERENCED
+		21:5:DEPRECATED_MESSAGES_NOT_REFERENCED
+		23:3:DEPRECATIONS_HAVE_COMMENTS
+		30:3:DEPRECATIONS_HAVE_COMMENTS
+		34:3:DEPRECATED_MESSAGES_NOT_REFERENCED
+		44:3:DEPRECATED_MESSAGES_NOT_REFERENCED`,
+		"testdata/lint/deprecations/foo.proto",
+	)
+	assertDoLintFiles(
+		t,
+		false,
+		`testdata/lint/deprecationsdirs/baz/v1/baz.proto:8:3:DEPRECATED_MESSAGES_NOT_REFERENCED
+		testdata/lint/deprecationsdirs/baz/v1/baz.proto:15:3:DEPRECATED_MESSAGES_NOT_REFERENCED`,
+		"testdata/lint/deprecationsdirs",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		`could not parse deprecation max_age "3x": time: unknown unit "x" in duration "3x"`,
+		"lint", "testdata/lint/deprecations/foo.proto",
+		"--config-data", `{"lint":{"deprecations":{"max_age":"3x"}}}`,
+	)
+}
+
+func TestLintSeverities(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		true,
+		`1:1:FILE_OPTIONS_REQUIRE_GO_PACKAGE
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_MULTIPLE_FILES
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_PACKAGE`,
+		"testdata/lint/severities/severities.proto",
+	)
+	assertDoLintFile(
+		t,
+		false,
+		`1:1:FILE_OPTIONS_REQUIRE_GO_PACKAGE
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_MULTIPLE_FILES
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME
+		1:1:FILE_OPTIONS_REQUIRE_JAVA_PACKAGE`,
+		"testdata/lint/severities/severities.proto",
+		"--fail-on", "warning",
+	)
+	assertDo(
+		t,
+		false,
+		0,
+		`testdata/lint/severities/severities.proto:FILE_OPTIONS_REQUIRE_GO_PACKAGE:warning
+		testdata/lint/severities/severities.proto:FILE_OPTIONS_REQUIRE_JAVA_MULTIPLE_FILES:warning
+		testdata/lint/severities/severities.proto:FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME:info
+		testdata/lint/severities/severities.proto:FILE_OPTIONS_REQUIRE_JAVA_PACKAGE:info`,
+		"lint", "testdata/lint/severities/severities.proto",
+		"--error-format", "filename:id:severity",
+	)
+	assertExact(
+		t,
+		true,
+		1,
+		"could not parse fatal to a severity, must be one of error, warning, info",
+		"lint", "testdata/lint/severities/severities.proto",
+		"--fail-on", "fatal",
+	)
+}
+
+func TestLintOutputFormat(t *testing.T) {
+	t.Parallel()
+	assertExact(
+		t,
+		false,
+		0,
+		`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="testdata/lint/severities/severities.proto">
+    <error severity="warning" message="File option &#34;go_package&#34; is required." source="prototool.FILE_OPTIONS_REQUIRE_GO_PACKAGE"></error>
+    <error severity="warning" message="File option &#34;java_multiple_files&#34; is required." source="prototool.FILE_OPTIONS_REQUIRE_JAVA_MULTIPLE_FILES"></error>
+    <error severity="info" message="File option &#34;java_outer_classname&#34; is required." source="prototool.FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME"></error>
+    <error severity="info" message="File option &#34;java_package&#34; is required." source="prototool.FILE_OPTIONS_REQUIRE_JAVA_PACKAGE"></error>
+  </file>
+</checkstyle>`,
+		"lint", "testdata/lint/severities/severities.proto",
+		"--output-format", "checkstyle",
+	)
+	stdout, exitCode := testDo(t, false, "lint", "testdata/lint/severities/severities.proto", "--output-format", "sarif", "--fail-on", "warning")
+	assert.Equal(t, 255, exitCode)
+	assert.Contains(t, stdout, `"id": "FILE_OPTIONS_REQUIRE_GO_PACKAGE"`)
+	assert.Contains(t, stdout, `"text": "Verifies that the file option \"go_package\" is set."`)
+	assert.Contains(t, stdout, `"level": "note"`)
+	assert.Contains(t, stdout, `"uri": "testdata/lint/severities/severities.proto"`)
+	assertExact(
+		t,
+		false,
+		255,
+		`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="prototool" tests="1" failures="1">
+  <testsuite name="testdata/format/proto3/foo/bar/bar.proto" tests="1" failures="1">
+    <testcase name="FORMAT_DIFF" classname="testdata/format/proto3/foo/bar/bar.proto">
+      <failure message="Format returned a diff." type="FORMAT_DIFF">testdata/format/proto3/foo/bar/bar.proto:1:1:error:Format returned a diff.&#xA;Checks that the file is formatted.</failure>
+    </testcase>
+  </testsuite>
+</testsuites>`,
+		"format", "-l", "testdata/format/proto3/foo/bar/bar.proto",
+		"--output-format", "junit",
+	)
+	assertExact(
+		t,
+		false,
+		255,
+		"can only set output-format with lint",
+		"format", "testdata/format/proto3/foo/bar/bar.proto",
+		"--output-format", "junit",
+	)
+	assertExact(
+		t,
+		false,
+		255,
+		"can only set one of json, output-format",
+		"lint", "testdata/lint/severities/severities.proto",
+		"--output-format", "sarif", "--json",
+	)
+	assertExact(
+		t,
+		false,
+		1,
+		"could not parse xml to an output format, must be one of sarif, checkstyle, junit",
+		"lint", "testdata/lint/severities/severities.proto",
+		"--output-format", "xml",
+	)
+}
+
+func TestLintGroups(t *testing.T) {
+	t.Parallel()
+	assertDoLintFile(
+		t,
+		false,
+		`1:1:FILE_OPTIONS_REQUIRE_GO_PACKAGE
+		5:1:MESSAGE_NAMES_CAPITALIZED`,
+		"testdata/lint/groups/groups.proto",
+	)
+	assertExact(t, false, 0, "acme\nacme_base\naip\ngoogle\nuber1\nuber2", "lint", "--list-all-lint-groups", "testdata/lint/groups")
+	assertExact(t, false, 0, "> FILE_OPTIONS_REQUIRE_GO_PACKAGE", "lint", "--diff-lint-groups", "google,acme", "testdata/lint/groups")
+	assertExact(t, false, 0, "> FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME", "lint", "--diff-lint-groups", "acme,acme_base", "testdata/lint/groups")
+	assertExact(t, false, 255, "unknown lint group: acme", "lint", "--list-lint-group", "acme", "testdata/lint/base")
+	assertExact(
+		t,
+		false,
+		1,
+		"lint group google is a built-in lint group and cannot be redefined",
+		"lint", "testdata/lint/groups/groups.proto",
+		"--config-data", `{"lint":{"groups":[{"name":"google","extends":"uber2"}]}}`,
+	)
+	assertExact(
+		t,
+		false,
+		1,
+		"lint group one transitively extends itself",
+		"lint", "testdata/lint/groups/groups.proto",
+		"--config-data", `{"lint":{"group":"one","groups":[{"name":"one","extends":"two"},{"name":"two","extends":"one"}]}}`,
+	)
+	assertExact(
+		t,
+		false,
+		1,
+		"duplicate lint group: one",
+		"lint", "testdata/lint/groups/groups.proto",
+		"--config-data", `{"lint":{"groups":[{"name":"one"},{"name":"ONE"}]}}`,
+	)
+}
+
+func TestLintExplain(t *testing.T) {
+	t.Parallel()
+	assertExact(
+		t,
+		false,
+		0,
+		`SYNTAX_PROTO3
+
+Verifies that the syntax is proto3.
+
+Default severity:
+
+error
+
+Rationale:
+
+proto3 is simpler than proto2, has a canonical JSON mapping, and is supported by every code generator. Run prototool migrate proto3 to migrate proto2 files.
+
+Bad example:
+
+syntax = "proto2";
+
+package foo.v1;
+
+Good example:
+
+syntax = "proto3";
+
+package foo.v1;`,
+		"lint", "--explain", "syntax_proto3",
+	)
+	assertExact(t, false, 255, "unknown lint id: FOO", "lint", "--explain", "FOO")
+	assertExact(t, false, 255, "can only set one of list-all-linters, list-linters, list-all-lint-groups, list-lint-group, diff-lint-groups, explain, fix", "lint", "--explain", "SYNTAX_PROTO3", "--list-all-linters")
+
+	stdout, exitCode := testDo(t, false, "lint", "--list-all-linters", "--json", "testdata/lint/base")
+	require.Equal(t, 0, exitCode)
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	require.Len(t, lines, len(lint.AllLinters))
+	for _, line := range lines {
+		linter := make(map[string]string)
+		require.NoError(t, json.Unmarshal([]byte(line), &linter))
+		assert.NotEmpty(t, linter["id"])
+		assert.NotEmpty(t, linter["purpose"])
+		assert.NotEmpty(t, linter["rationale"])
+		assert.NotEmpty(t, linter["bad_example"])
+		assert.NotEmpty(t, linter["good_example"])
+	}
+}
+
+func TestLintFix(t *testing.T) {
+	t.Parallel()
+	tmpDir := copyTestdataDir(t, "testdata/lint/fix")
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	relTmpDir, err := filepath.Rel(cwd, tmpDir)
+	require.NoError(t, err)
+	fooFilePath := filepath.Join(relTmpDir, "foo", "v1", "foo.proto")
+	barFilePath := filepath.Join(relTmpDir, "foo", "v1", "bar.proto")
+
+	assertExact(t, false, 255, "can only set allow-breaking-fixes with fix", "lint", "--allow-breaking-fixes", tmpDir)
+	// only the field names with unchanged JSON names are fixed
+	assertDo(
+		t,
+		true,
+		255,
+		barFilePath+`:8:3:RPC_NAMES_CAMEL_CASE:Not fixing RPC "get_bar" to "GetBar" as the fix is breaking
+		`+fooFilePath+`:5:1:MESSAGE_NAMES_CAMEL_CASE:Not fixing message "foo_bar" to "FooBar" as the fix is breaking
+		`+fooFilePath+`:5:1:MESSAGE_NAMES_CAPITALIZED:Not fixing message "foo_bar" to "FooBar" as the fix is breaking
+		`+fooFilePath+`:6:3:MESSAGE_NAMES_CAMEL_CASE:Not fixing message "inner_value" to "InnerValue" as the fix is breaking
+		`+fooFilePath+`:6:3:MESSAGE_NAMES_CAPITALIZED:Not fixing message "inner_value" to "InnerValue" as the fix is breaking
+		`+fooFilePath+`:11:3:MESSAGE_FIELD_NAMES_LOWER_SNAKE_CASE:Not fixing field "Baz" to "baz" as the fix is breaking, the JSON name changes from "Baz" to "baz".
+		`+fooFilePath+`:15:1:ENUM_NAMES_CAPITALIZED:Not fixing enum "status" to "Status" as the fix is breaking
+		`+fooFilePath+`:16:3:ENUM_FIELD_PREFIXES_EXCEPT_MESSAGE:Not fixing enum value "INVALID" to "STATUS_INVALID" as the fix is breaking
+		`+fooFilePath+`:17:3:ENUM_FIELD_NAMES_UPPER_SNAKE_CASE:Not fixing enum value "status_active" to "STATUS_ACTIVE" as the fix is breaking
+		`+fooFilePath+`:17:3:ENUM_FIELD_PREFIXES_EXCEPT_MESSAGE:Not fixing enum value "status_active" to "STATUS_ACTIVE" as the fix is breaking`,
+		"lint", "--fix", tmpDir,
+	)
+	data, err := ioutil.ReadFile(filepath.Join(tmpDir, "foo", "v1", "foo.proto"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "int64 user_id = 1;")
+	assert.Contains(t, string(data), "string Baz = 3;")
+	assertDo(t, true, 0, "", "lint", "--fix", "--allow-breaking-fixes", tmpDir)
+	for _, filePath := range []string{"foo/v1/foo.proto", "foo/v1/bar.proto"} {
+		golden, err := ioutil.ReadFile(filepath.Join("testdata/lint/fix", filePath+".golden"))
+		require.NoError(t, err)
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, filePath))
+		require.NoError(t, err)
+		assert.Equal(t, string(golden), string(data), filePath)
+	}
+	assertDo(t, true, 0, "", "lint", tmpDir)
+}
+
 func TestLintConfigDataOverride(t *testing.T) {
 	cwd, err := os.Getwd()
 	require.NoError(t, err)
@@ -724,6 +1203,251 @@ func TestGoldenFormat(t *testing.T) {
 	assertGoldenFormat(t, false, false, "testdata/format/proto2/foo/foo_proto2.proto")
 	assertGoldenFormat(t, false, true, "testdata/format-fix/foo.proto")
 	assertGoldenFormat(t, false, true, "testdata/format-fix-v2/foo.proto")
+	assertGoldenFormat(t, false, false, "testdata/format/maxlinelength/foo.proto")
+	// formatting is idempotent with a maximum line length
+	assertGoldenFormat(t, true, false, "testdata/format/maxlinelength/formatted.proto")
+	assertGoldenFormat(t, false, false, "testdata/format/layout/foo.proto")
+	assertGoldenFormat(t, true, false, "testdata/format/layout/formatted.proto")
+	assertGoldenFormat(t, false, false, "testdata/format/tabs/foo.proto")
+	assertGoldenFormat(t, true, false, "testdata/format/tabs/formatted.proto")
+	// canonical ordering is only done with fix
+	assertGoldenFormat(t, false, true, "testdata/format/canonical/foo.proto")
+	assertGoldenFormat(t, true, true, "testdata/format/canonical/formatted.proto")
+	assertGoldenFormat(t, false, true, "testdata/format/comments/foo.proto")
+	assertGoldenFormat(t, true, true, "testdata/format/comments/formatted.proto")
+	// unused imports are deleted and missing imports are added with fix
+	assertGoldenFormat(t, false, true, "testdata/format/imports/foo.proto")
+}
+
+func TestFormatMaxLineLengthNegative(t *testing.T) {
+	t.Parallel()
+	assertExact(
+		t,
+		true,
+		1,
+		`max_line_length for format must be non-negative: -1`,
+		"format", "testdata/format/maxlinelength/foo.proto",
+		"--config-data", `{"format":{"max_line_length":-1}}`,
+	)
+}
+
+func TestFormatIndentWidthNegative(t *testing.T) {
+	t.Parallel()
+	assertExact(
+		t,
+		true,
+		1,
+		`indent_width for format must be non-negative: -1`,
+		"format", "testdata/format/layout/foo.proto",
+		"--config-data", `{"format":{"indent_width":-1}}`,
+	)
+}
+
+func TestFormatCommentWidthNegative(t *testing.T) {
+	t.Parallel()
+	assertExact(
+		t,
+		true,
+		1,
+		`comment_width for format must be non-negative: -1`,
+		"format", "testdata/format/comments/foo.proto",
+		"--config-data", `{"format":{"comment_width":-1}}`,
+	)
+}
+
+func TestFormatStdin(t *testing.T) {
+	t.Parallel()
+	assertGoldenFormatStdin(t, "testdata/format/stdin/foo.proto", "testdata/format/stdin/foo.proto.golden")
+	// only the message on lines 5 to 7 is formatted
+	assertGoldenFormatStdin(t, "testdata/format/stdin/foo.proto", "testdata/format/stdin/foo.proto.lines.golden", "--lines", "5:7")
+	assertExact(t, false, 255, "must set assume-filename with stdin", "format", "--stdin")
+	assertExact(t, false, 255, "cannot set overwrite with stdin", "format", "--stdin", "-w", "--assume-filename", "testdata/format/stdin/foo.proto")
+	assertExact(t, false, 255, "can only set lines with stdin", "format", "--lines", "5:7", "testdata/format/stdin/foo.proto")
+	assertExact(t, false, 255, `lines must have 1 <= start <= end but was "7:5"`, "format", "--stdin", "--assume-filename", "testdata/format/stdin/foo.proto", "--lines", "7:5")
+}
+
+func TestFormatDiff(t *testing.T) {
+	t.Parallel()
+	input, err := ioutil.ReadFile("testdata/format/stdin/foo.proto")
+	require.NoError(t, err)
+	output, exitCode := testDoStdin(t, bytes.NewReader(input), false, "format", "--stdin", "--assume-filename", "testdata/format/stdin/foo.proto", "-d", "--diff-context-lines", "1")
+	assert.Equal(t, 255, exitCode)
+	assert.Equal(t, `--- testdata/format/stdin/foo.proto.orig
++++ testdata/format/stdin/foo.proto
+@@ -5,3 +5,3 @@
+ message Hello {
+-    int64 id  =  1;
++  int64 id = 1;
+ }
+@@ -9,3 +9,3 @@
+ message Goodbye {
+-    int64 id  =  1;
++  int64 id = 1;
+ }`, output)
+	assertExact(t, false, 255, "diff-context-lines must be non-negative: -1", "format", "-d", "--diff-context-lines", "-1", "testdata/format/stdin/foo.proto")
+}
+
+func TestFormatNoCompile(t *testing.T) {
+	t.Parallel()
+	// foo.proto imports a file that does not exist, so it does not compile
+	output, exitCode := testDo(t, true, "format", "--no-compile", "testdata/format/nocompile/foo.proto")
+	assert.Equal(t, 255, exitCode)
+	golden, err := ioutil.ReadFile("testdata/format/nocompile/foo.proto.golden")
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(golden)), output)
+	assertDo(t, true, 255, `testdata/format/nocompile/invalid.proto:6:14:INVALID_PROTOBUF:found "=" but expected [field sequence number]`, "format", "--no-compile", "testdata/format/nocompile/invalid.proto")
+	assertDo(t, true, 255, `testdata/format/nocompile/unterminated.proto:5:21:INVALID_PROTOBUF:literal not terminated`, "format", "--no-compile", "-l", "testdata/format/nocompile/unterminated.proto")
+}
+
+func TestFormatFixPackage(t *testing.T) {
+	t.Parallel()
+	tmpDir := copyTestdataDir(t, "testdata/format/package")
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	assertExact(t, false, 255, `fix must be true, false or package: "foo"`, "format", "--fix=foo", tmpDir)
+	// baz.proto is not formatted, but references the moved type, so only
+	// the reference is changed
+	assertDo(t, false, 0, "", "format", "--fix=package", "-w", filepath.Join(tmpDir, "foo", "v1", "foo.proto"))
+	for _, filePath := range []string{"foo/v1/foo.proto", "baz/v1/baz.proto"} {
+		golden, err := ioutil.ReadFile(filepath.Join("testdata/format/package", filePath+".golden"))
+		require.NoError(t, err)
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, filePath))
+		require.NoError(t, err)
+		assert.Equal(t, string(golden), string(data), filePath)
+	}
+	assertDo(t, false, 0, "", "format", "--fix=package", "-l", filepath.Join(tmpDir, "foo"))
+}
+
+func TestFormatFixImportsOtherFile(t *testing.T) {
+	t.Parallel()
+	tmpDir := copyTestdataDir(t, "testdata/format/importsother")
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	bazFilePath := filepath.Join(tmpDir, "baz.proto")
+	original, err := ioutil.ReadFile(bazFilePath)
+	require.NoError(t, err)
+	// the missing import of baz.proto is not fixed when only foo.proto is
+	// formatted, so it is reported instead of being dropped
+	_, exitCode := testDo(t, false, "format", "--fix", "-w", filepath.Join(tmpDir, "foo.proto"))
+	assert.Equal(t, 255, exitCode)
+	data, err := ioutil.ReadFile(bazFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, string(original), string(data))
+	// the files are compiled again after the imports are fixed
+	assertDo(t, false, 0, "", "format", "--fix", "-w", tmpDir)
+	golden, err := ioutil.ReadFile("testdata/format/importsother/baz.proto.golden")
+	require.NoError(t, err)
+	data, err = ioutil.ReadFile(bazFilePath)
+	require.NoError(t, err)
+	assert.Equal(t, string(golden), string(data))
+}
+
+func TestMove(t *testing.T) {
+	t.Parallel()
+	tmpDir := copyTestdataDir(t, "testdata/mv")
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	barFilePath := filepath.Join(tmpDir, "foo", "v1", "bar.proto")
+	assertExact(t, false, 255, filepath.Join(tmpDir, "foo", "v1", "bar.txt")+" is not a .proto file", "mv", barFilePath, filepath.Join(tmpDir, "foo", "v1", "bar.txt"))
+	assertDo(t, false, 0, "", "mv", barFilePath, filepath.Join(tmpDir, "qux", "v1")+"/")
+	_, err := os.Stat(barFilePath)
+	assert.True(t, os.IsNotExist(err))
+	// the names of custom options are references to the moved extensions
+	assertDo(t, false, 0, "", "mv", filepath.Join(tmpDir, "foo", "v1", "opts.proto"), filepath.Join(tmpDir, "qux", "v1")+"/")
+	for _, filePath := range []string{"qux/v1/bar.proto", "foo/v1/foo.proto", "other/v1/other.proto", "qux/v1/opts.proto", "other/v1/thing.proto"} {
+		golden, err := ioutil.ReadFile(filepath.Join("testdata/mv", filePath+".golden"))
+		require.NoError(t, err)
+		data, err := ioutil.ReadFile(filepath.Join(tmpDir, filePath))
+		require.NoError(t, err)
+		assert.Equal(t, string(golden), string(data), filePath)
+	}
+	assertDo(t, false, 0, "", "compile", tmpDir)
+}
+
+func TestMigrateProto3(t *testing.T) {
+	t.Parallel()
+	// bar.proto cannot be converted, so only the diff for foo.proto is printed
+	assertExact(t, true, 255, `--- testdata/migrate/proto3/foo.proto.orig
++++ testdata/migrate/proto3/foo.proto
+@@ -3 +3 @@
+-syntax = "proto2";
++syntax = "proto3";
+@@ -15 +15 @@
+-  optional string my_option = 50000;
++  string my_option = 50000;
+@@ -20,4 +20,4 @@
+-  required int64 id = 1;
+-  optional string name = 2 [default = "hello"];
+-  optional Bar bar = 3;
+-  optional Baz baz = 4 [default = BAZ_ONE];
++  int64 id = 1;
++  string name = 2;
++  Bar bar = 3;
++  Baz baz = 4;
+@@ -26,2 +26,6 @@
+-  optional group Result = 6 {
+-    optional string url = 7;
++  message Result {
++    string url = 7;
++  }
++  Result result = 6;
++  message Item {
++    int32 count = 10;
+@@ -30,4 +34,2 @@
+-    string text = 8 [default = "text"];
+-    group Item = 9 {
+-      optional int32 count = 10;
+-    }
++    string text = 8;
++    Item item = 9;
+@@ -36 +37,0 @@
+-    NESTED_ONE = 1;
+@@ -37,0 +39 @@
++    NESTED_ONE = 1;
+@@ -42 +44 @@
+-  optional int32 count = 1;
++  int32 count = 1;
+@@ -45,0 +48 @@
++  BAZ_INVALID = 0;
+testdata/migrate/proto3/bar.proto:11:12:PROTO3_BREAKING_CHANGE:Field "id" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/bar.proto:12:3:PROTO3_NOT_CONVERTED:Extension ranges are not allowed in proto3.
+testdata/migrate/proto3/bar.proto:15:1:PROTO3_NOT_CONVERTED:Extensions of "Extendable" are not allowed in proto3, only extensions of the descriptor options for custom options are.
+testdata/migrate/proto3/foo.proto:20:12:PROTO3_BREAKING_CHANGE:Field "id" is no longer required, so messages without it are no longer rejected when parsed.
+testdata/migrate/proto3/foo.proto:21:12:PROTO3_BREAKING_CHANGE:Field "name" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/foo.proto:21:28:PROTO3_BREAKING_CHANGE:Default value "hello" of field "name" is removed, so the field defaults to the zero value of its type.
+testdata/migrate/proto3/foo.proto:23:12:PROTO3_BREAKING_CHANGE:Field "baz" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/foo.proto:23:24:PROTO3_BREAKING_CHANGE:Default value BAZ_ONE of field "baz" is removed, so the field defaults to the zero value of its type.
+testdata/migrate/proto3/foo.proto:26:12:PROTO3_BREAKING_CHANGE:Group "Result" is converted to the nested message "Result" and field "result", which changes the wire format of the field from a group to a message.
+testdata/migrate/proto3/foo.proto:27:14:PROTO3_BREAKING_CHANGE:Field "url" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/foo.proto:30:21:PROTO3_BREAKING_CHANGE:Default value "text" of field "text" is removed, so the field defaults to the zero value of its type.
+testdata/migrate/proto3/foo.proto:31:5:PROTO3_BREAKING_CHANGE:Group "Item" is converted to the nested message "Item" and field "item", which changes the wire format of the field from a group to a message.
+testdata/migrate/proto3/foo.proto:32:16:PROTO3_BREAKING_CHANGE:Field "count" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/foo.proto:35:3:PROTO3_BREAKING_CHANGE:Zero value "NESTED_ZERO" of enum "Nested" is moved to be the first value, which changes the default of fields of this enum from "NESTED_ONE".
+testdata/migrate/proto3/foo.proto:42:12:PROTO3_BREAKING_CHANGE:Field "count" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/proto3/foo.proto:45:1:PROTO3_BREAKING_CHANGE:Zero value "BAZ_INVALID" is added to enum "Baz", which changes the default of fields of this enum from "BAZ_ONE".`, "migrate", "proto3", "-d", "--diff-context-lines", "0", "testdata/migrate/proto3")
+	// the presence of fields of enums from other files is known from the compiled descriptors
+	assertExact(t, true, 255, `--- testdata/migrate/enums/color.proto.orig
++++ testdata/migrate/enums/color.proto
+@@ -1 +1 @@
+-syntax = "proto2";
++syntax = "proto3";
+--- testdata/migrate/enums/paint.proto.orig
++++ testdata/migrate/enums/paint.proto
+@@ -1 +1 @@
+-syntax = "proto2";
++syntax = "proto3";
+@@ -8 +8 @@
+-  optional Color color = 1;
++  Color color = 1;
+testdata/migrate/enums/paint.proto:8:12:PROTO3_BREAKING_CHANGE:Field "color" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.`, "migrate", "proto3", "-d", "--diff-context-lines", "0", "testdata/migrate/enums")
+	// proto3 files cannot use the enums of proto2 files that are not migrated
+	assertExact(t, true, 255, `testdata/migrate/enums/paint.proto:8:12:PROTO3_BREAKING_CHANGE:Field "color" no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.
+testdata/migrate/enums/paint.proto:8:12:PROTO3_NOT_CONVERTED:Field "color" uses the enum "foo.Color" of the proto2 file "color.proto", which cannot be used in proto3 unless that file is migrated as well.
+testdata/migrate/enums/paint.proto:9:3:PROTO3_NOT_CONVERTED:Field "colors" uses the enum "foo.Color" of the proto2 file "color.proto", which cannot be used in proto3 unless that file is migrated as well.
+testdata/migrate/enums/paint.proto:11:5:PROTO3_NOT_CONVERTED:Field "other_color" uses the enum "foo.Color" of the proto2 file "color.proto", which cannot be used in proto3 unless that file is migrated as well.`, "migrate", "proto3", "-d", "--diff-context-lines", "0", "testdata/migrate/enums/paint.proto")
 }
 
 func TestCreate(t *testing.T) {
@@ -943,6 +1667,25 @@ syntax = "proto3";
 
 package foo;
 
+option csharp_namespace = "Foo";
+option go_package = "foopb";
+option java_multiple_files = true;
+option java_outer_classname = "BazProto";
+option java_package = "com.foo";
+option objc_class_prefix = "FXX";
+option php_namespace = "Foo";`,
+	)
+	// in dir with prototool.yaml with a lint group that extends uber2
+	assertDoCreateFile(
+		t,
+		true,
+		true,
+		"testdata/create/version2five/baz.proto",
+		"",
+		`syntax = "proto3";
+
+package foo;
+
 option csharp_namespace = "Foo";
 option go_package = "foopb";
 option java_multiple_files = true;
@@ -1209,7 +1952,7 @@ func TestListAllLinters(t *testing.T) {
 }
 
 func TestListAllLintGroups(t *testing.T) {
-	assertExact(t, true, 0, "google\nuber1\nuber2", "lint", "--list-all-lint-groups")
+	assertExact(t, true, 0, "aip\ngoogle\nuber1\nuber2", "lint", "--list-all-lint-groups")
 }
 
 func TestListLintGroup(t *testing.T) {
@@ -1260,6 +2003,31 @@ func assertLinters(t *testing.T, linters []lint.Linter, args ...string) {
 	assertDo(t, true, 0, strings.Join(linterIDs, "\n"), args...)
 }
 
+// copyTestdataDir copies the files in the directory, except for .golden files,
+// to a new temporary directory, and returns the path of the temporary directory.
+func copyTestdataDir(t *testing.T, dirPath string) string {
+	tmpDir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	require.NoError(t, filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) == ".golden" {
+			return err
+		}
+		relPath, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Join(tmpDir, filepath.Dir(relPath)), 0755); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(tmpDir, relPath), data, 0644)
+	}))
+	return tmpDir
+}
+
 func assertDoCompileFiles(t *testing.T, expectSuccess bool, asJSON bool, expectedLinePrefixes string, filePaths ...string) {
 	lines := getCleanLines(expectedLinePrefixes)
 	expectedExitCode := 0
@@ -1331,6 +2099,17 @@ func assertGoldenFormat(t *testing.T, expectSuccess bool, fix bool, filePath str
 	assert.Equal(t, strings.TrimSpace(string(golden)), output)
 }
 
+func assertGoldenFormatStdin(t *testing.T, filePath string, goldenFilePath string, extraArgs ...string) {
+	input, err := ioutil.ReadFile(filePath)
+	require.NoError(t, err)
+	args := append([]string{"format", "--stdin", "--assume-filename", filePath}, extraArgs...)
+	output, exitCode := testDoStdin(t, bytes.NewReader(input), true, args...)
+	assert.Equal(t, 0, exitCode)
+	golden, err := ioutil.ReadFile(goldenFilePath)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(golden)), output)
+}
+
 func assertGRPC(t *testing.T, expectedExitCode int, expectedLinePrefixes string, filePath string, method string, jsonData string) {
 	excitedTestCase := startExcitedTestCase(t)
 	defer excitedTestCase.Close()
@@ -22,54 +22,78 @@ package cmd
 
 import (
 	"github.com/spf13/pflag"
+	"github.com/uber/prototool/internal/diff"
 )
 
 type flags struct {
-	allowBetaDeps     bool
-	address           string
-	cachePath         string
-	callTimeout       string
-	configData        string
-	connectTimeout    string
-	data              string
-	debug             bool
-	diffLintGroups    string
-	diffMode          bool
-	disableFormat     bool
-	disableLint       bool
-	dryRun            bool
-	errorFormat       string
-	fix               bool
-	gitBranch         string
-	gitTag            string
-	headers           []string
-	includeBeta       bool
-	keepaliveTime     string
-	json              bool
-	listAllLinters    bool
-	listLinters       bool
-	listAllLintGroups bool
-	listLintGroup     string
-	lintMode          bool
-	method            string
-	name              string
-	overwrite         bool
-	pkg               string
-	protocBinPath     string
-	protocWKTPath     string
-	protocURL         string
-	stdin             bool
-	uncomment         bool
+	allowBetaDeps      bool
+	allowBreakingFixes bool
+	address            string
+	assumeFilename     string
+	cache              bool
+	cachePath          string
+	callTimeout        string
+	color              bool
+	configData         string
+	connectTimeout     string
+	data               string
+	debug              bool
+	diffContextLines   int
+	diffLintGroups     string
+	diffMode           bool
+	disableFormat      bool
+	disableLint        bool
+	dryRun             bool
+	errorFormat        string
+	explain            string
+	failOn             string
+	fix                bool
+	formatFix          string
+	gitBranch          string
+	gitTag             string
+	headers            []string
+	includeBeta        bool
+	keepaliveTime      string
+	json               bool
+	listAllLinters     bool
+	listLinters        bool
+	listAllLintGroups  bool
+	listLintGroup      string
+	lines              string
+	lintMode           bool
+	method             string
+	name               string
+	noCompile          bool
+	outputFormat       string
+	overwrite          bool
+	pkg                string
+	protocBinPath      string
+	protocWKTPath      string
+	protocURL          string
+	stdin              bool
+	uncomment          bool
 }
 
 func (f *flags) bindAllowBetaDeps(flagSet *pflag.FlagSet) {
 	flagSet.BoolVar(&f.allowBetaDeps, "allow-beta-deps", false, "Allow stable packages to depend on beta packages. This is implicitly set if --include-beta is set.")
 }
 
+func (f *flags) bindAllowBreakingFixes(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&f.allowBreakingFixes, "allow-breaking-fixes", false, "Also apply fixes that break wire or JSON compatibility, such as renaming enum values or RPCs. This must be used with --fix.")
+}
+
 func (f *flags) bindAddress(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.address, "address", "", "The GRPC endpoint to connect to. This is required.")
 }
 
+func (f *flags) bindAssumeFilename(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&f.assumeFilename, "assume-filename", "", "The path of the file read from stdin, used to find the configuration and update file options. This is required with --stdin.")
+}
+
+func (f *flags) bindCache(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&f.cache, "cache", false, "Cache the lint failures of each directory in the cache path, keyed by the hashes of the file contents, the configuration and the linters, and reuse the failures for unchanged files. If no file changed, compilation is also skipped. The cache is deleted by prototool cache delete.")
+}
+
 func (f *flags) bindCachePath(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.cachePath, "cache-path", "", "The path to use for the cache, otherwise uses the default behavior. The user is expected to clean and manage this cache path. See prototool help cache update for more details.")
 }
@@ -78,6 +102,10 @@ func (f *flags) bindCallTimeout(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.callTimeout, "call-timeout", "60s", "The maximum time to for all calls to be completed.")
 }
 
+func (f *flags) bindColor(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&f.color, "color", false, "Color the diff written with --diff.")
+}
+
 func (f *flags) bindConfigData(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.configData, "config-data", "", "The configuration data to use instead of reading prototool.yaml or prototool.json files.\nThis will act as if there is a configuration file with the given data in the current directory, and no other configuration files recursively.\nThis is an advanced feature and is not recommended to be generally used.")
 }
@@ -98,6 +126,10 @@ func (f *flags) bindDiffLintGroups(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.diffLintGroups, "diff-lint-groups", "", "Diff the two lint groups separated by '.', for example google,uber2.")
 }
 
+func (f *flags) bindDiffContextLines(flagSet *pflag.FlagSet) {
+	flagSet.IntVar(&f.diffContextLines, "diff-context-lines", diff.DefaultContextLines, "The number of unchanged lines to write around each change in the diff written with --diff.")
+}
+
 func (f *flags) bindDiffMode(flagSet *pflag.FlagSet) {
 	flagSet.BoolVarP(&f.diffMode, "diff", "d", false, "Write a diff instead of writing the formatted file to stdout.")
 }
@@ -115,7 +147,15 @@ func (f *flags) bindDryRun(flagSet *pflag.FlagSet) {
 }
 
 func (f *flags) bindErrorFormat(flagSet *pflag.FlagSet) {
-	flagSet.StringVar(&f.errorFormat, "error-format", "filename:line:column:message", `The colon-separated fields to print out on error. Valid values are "filename:line:column:id:message".`)
+	flagSet.StringVar(&f.errorFormat, "error-format", "filename:line:column:message", `The colon-separated fields to print out on error. Valid values are "filename:line:column:id:severity:message".`)
+}
+
+func (f *flags) bindExplain(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&f.explain, "explain", "", "Print the rationale and examples for the given linter ID instead of running lint.")
+}
+
+func (f *flags) bindFailOn(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&f.failOn, "fail-on", "error", `The minimum severity of lint failures that results in a non-zero exit code. Valid values are "error", "warning", "info".`)
 }
 
 func (f *flags) bindGitBranch(flagSet *pflag.FlagSet) {
@@ -162,6 +202,10 @@ func (f *flags) bindListLintGroup(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.listLintGroup, "list-lint-group", "", "List the linters in the given lint group instead of running lint.")
 }
 
+func (f *flags) bindLines(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&f.lines, "lines", "", "Only apply the formatting changes that touch the given range of lines of the form start:end, starting at 1 and inclusive. This must be used with --stdin.")
+}
+
 func (f *flags) bindMethod(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.method, "method", "", "The GRPC method to call in the form package.Service/Method. This is required.")
 }
@@ -170,6 +214,10 @@ func (f *flags) bindName(flagSet *pflag.FlagSet) {
 	flagSet.StringVar(&f.name, "name", "", "The package name. This is required.")
 }
 
+func (f *flags) bindOutputFormat(flagSet *pflag.FlagSet) {
+	flagSet.StringVar(&f.outputFormat, "output-format", "", `Print all failures in the given format instead of using the error format. Valid values are "sarif", "checkstyle", "junit".`)
+}
+
 func (f *flags) bindOverwrite(flagSet *pflag.FlagSet) {
 	flagSet.BoolVarP(&f.overwrite, "overwrite", "w", false, "Overwrite the existing file instead of writing the formatted file to stdout.")
 }
@@ -194,6 +242,10 @@ func (f *flags) bindStdin(flagSet *pflag.FlagSet) {
 	flagSet.BoolVar(&f.stdin, "stdin", false, "Read the GRPC request data from stdin in JSON format. Either this or --data is required.")
 }
 
+func (f *flags) bindFormatStdin(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&f.stdin, "stdin", false, "Read the file to format from stdin and write the result to stdout instead of formatting files on disk. The file is not compiled with protoc. This must be used with --assume-filename.")
+}
+
 func (f *flags) bindUncomment(flagSet *pflag.FlagSet) {
 	flagSet.BoolVar(&f.uncomment, "uncomment", false, "Uncomment the example config settings.")
 }
@@ -201,3 +253,17 @@ func (f *flags) bindUncomment(flagSet *pflag.FlagSet) {
 func (f *flags) bindFix(flagSet *pflag.FlagSet) {
 	flagSet.BoolVarP(&f.fix, "fix", "f", false, "Fix the file according to the Style Guide.")
 }
+
+func (f *flags) bindNoCompile(flagSet *pflag.FlagSet) {
+	flagSet.BoolVar(&f.noCompile, "no-compile", false, "Do not compile with protoc before formatting, so that protoc is not downloaded. Syntax errors are still reported, but imports are not fixed with --fix.")
+}
+
+func (f *flags) bindFormatFix(flagSet *pflag.FlagSet) {
+	flagSet.StringVarP(&f.formatFix, "fix", "f", "", "Fix the file according to the Style Guide. If set to package, also move the file to the package for its directory per create.packages, updating the imports of and references to the file in all files.")
+	// --fix and -f without a value are the same as --fix=true
+	flagSet.Lookup("fix").NoOptDefVal = "true"
+}
+
+func (f *flags) bindLintFix(flagSet *pflag.FlagSet) {
+	flagSet.BoolVarP(&f.fix, "fix", "f", false, "Rename declarations to fix naming lint failures, updating references to renamed types, and overwrite the files.")
+}
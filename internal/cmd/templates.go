@@ -50,6 +50,7 @@ var (
 			flags.bindDisableFormat(flagSet)
 			flags.bindDisableLint(flagSet)
 			flags.bindErrorFormat(flagSet)
+			flags.bindFailOn(flagSet)
 			flags.bindJSON(flagSet)
 			flags.bindFix(flagSet)
 			flags.bindProtocURL(flagSet)
@@ -76,6 +77,7 @@ The input directory must be relative.`,
 			flags.bindGitTag(flagSet)
 			flags.bindJSON(flagSet)
 			flags.bindIncludeBeta(flagSet)
+			flags.bindOutputFormat(flagSet)
 			flags.bindProtocURL(flagSet)
 			flags.bindProtocBinPath(flagSet)
 			flags.bindProtocWKTPath(flagSet)
@@ -136,6 +138,7 @@ Artifacts are downloaded to the following directories based on flags and environ
 			flags.bindDryRun(flagSet)
 			flags.bindErrorFormat(flagSet)
 			flags.bindJSON(flagSet)
+			flags.bindOutputFormat(flagSet)
 			flags.bindProtocURL(flagSet)
 			flags.bindProtocBinPath(flagSet)
 			flags.bindProtocWKTPath(flagSet)
@@ -228,19 +231,29 @@ If Vim integration is set up, files will be generated when you open a new Protob
 	formatCmdTemplate = &cmdTemplate{
 		Use:   "format [dirOrFile]",
 		Short: "Format a proto file and compile with protoc to check for failures.",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `If --stdin is set, the file is read from stdin and the result is written to stdout without compiling with protoc or writing to disk, which is meant for editor integrations. --assume-filename is required and is used to find the configuration, and --lines can be set to only apply the formatting changes that touch a range of lines, for example "--lines 10:40".
+
+If --no-compile is set, the files are only parsed and not compiled with protoc, so that protoc is not needed, which is meant for pre-commit hooks. Syntax errors are still reported, but other errors such as undefined types are not, and imports are not fixed with --fix.`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(runner exec.Runner, args []string, flags *flags) error {
-			return runner.Format(args, flags.overwrite, flags.diffMode, flags.lintMode, flags.fix)
+			return runner.Format(args, flags.overwrite, flags.diffMode, flags.lintMode, flags.formatFix, flags.stdin, flags.noCompile, flags.assumeFilename, flags.lines)
 		},
 		BindFlags: func(flagSet *pflag.FlagSet, flags *flags) {
+			flags.bindAssumeFilename(flagSet)
 			flags.bindCachePath(flagSet)
+			flags.bindColor(flagSet)
 			flags.bindConfigData(flagSet)
+			flags.bindDiffContextLines(flagSet)
 			flags.bindDiffMode(flagSet)
 			flags.bindErrorFormat(flagSet)
 			flags.bindJSON(flagSet)
 			flags.bindLintMode(flagSet)
+			flags.bindOutputFormat(flagSet)
 			flags.bindOverwrite(flagSet)
-			flags.bindFix(flagSet)
+			flags.bindFormatFix(flagSet)
+			flags.bindNoCompile(flagSet)
+			flags.bindFormatStdin(flagSet)
+			flags.bindLines(flagSet)
 			flags.bindProtocURL(flagSet)
 			flags.bindProtocBinPath(flagSet)
 			flags.bindProtocWKTPath(flagSet)
@@ -428,7 +441,9 @@ $ cat input.json | prototool grpc example \
 	lintCmdTemplate = &cmdTemplate{
 		Use:   "lint [dirOrFile]",
 		Short: "Lint proto files and compile with protoc to check for failures.",
-		Long: `Lint rules can be set using the configuration file. See the configuration at https://github.com/uber/prototool/blob/dev/etc/config/example/prototool.yaml for all available options. There are two pre-configured groups of rules:
+		Long: `Lint rules can be set using the configuration file. See the configuration at https://github.com/uber/prototool/blob/dev/etc/config/example/prototool.yaml for all available options. There are three pre-configured groups of rules:
+
+aip: This lint group extends the google lint group with rules for resource-oriented APIs that follow the Google API Improvement Proposals at https://aip.dev, such as standard method types, pagination fields, update masks and "google.api.http" options that match the standard method.
 
 google: This lint group follows the Style Guide at https://developers.google.com/protocol-buffers/docs/style. This is a small group of rules meant to enforce basic naming, and is widely followed.
 
@@ -467,18 +482,67 @@ sys	0m0.924s`,
 
 		Args: cobra.MaximumNArgs(1),
 		Run: func(runner exec.Runner, args []string, flags *flags) error {
-			return runner.Lint(args, flags.listAllLinters, flags.listLinters, flags.listAllLintGroups, flags.listLintGroup, flags.diffLintGroups)
+			return runner.Lint(args, flags.listAllLinters, flags.listLinters, flags.listAllLintGroups, flags.listLintGroup, flags.diffLintGroups, flags.explain, flags.fix, flags.allowBreakingFixes, flags.cache)
 		},
 		BindFlags: func(flagSet *pflag.FlagSet, flags *flags) {
+			flags.bindAllowBreakingFixes(flagSet)
+			flags.bindCache(flagSet)
 			flags.bindCachePath(flagSet)
 			flags.bindConfigData(flagSet)
 			flags.bindErrorFormat(flagSet)
+			flags.bindExplain(flagSet)
+			flags.bindFailOn(flagSet)
+			flags.bindLintFix(flagSet)
 			flags.bindJSON(flagSet)
 			flags.bindListAllLinters(flagSet)
 			flags.bindListLinters(flagSet)
 			flags.bindListAllLintGroups(flagSet)
 			flags.bindListLintGroup(flagSet)
 			flags.bindDiffLintGroups(flagSet)
+			flags.bindOutputFormat(flagSet)
+			flags.bindProtocURL(flagSet)
+			flags.bindProtocBinPath(flagSet)
+			flags.bindProtocWKTPath(flagSet)
+		},
+	}
+
+	migrateProto3CmdTemplate = &cmdTemplate{
+		Use:   "proto3 [dirOrFile]",
+		Short: "Migrate proto2 files to proto3.",
+		Long:  `The files are rewritten to proto3 syntax with the format settings. Labels, defaults and groups are converted and enums are given a zero value, which is named <ENUM>_INVALID as required by ENUM_ZERO_VALUES_INVALID if one does not exist. Each change that can break wire compatibility or the behavior of existing code is printed as a PROTO3_BREAKING_CHANGE warning. Elements that cannot be converted safely, such as extension ranges, extensions of messages other than the descriptor options and fields of enums from proto2 files that are not migrated, are printed as PROTO3_NOT_CONVERTED errors, and if there are any, no files are changed. The files are compiled with protoc before and after the migration, and if they do not compile after the migration, the original files are restored. If --diff is set, the changes are printed as diffs instead of being written.`,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(runner exec.Runner, args []string, flags *flags) error {
+			return runner.MigrateProto3(args, flags.diffMode)
+		},
+		BindFlags: func(flagSet *pflag.FlagSet, flags *flags) {
+			flags.bindCachePath(flagSet)
+			flags.bindColor(flagSet)
+			flags.bindConfigData(flagSet)
+			flags.bindDiffContextLines(flagSet)
+			flags.bindDiffMode(flagSet)
+			flags.bindErrorFormat(flagSet)
+			flags.bindJSON(flagSet)
+			flags.bindProtocURL(flagSet)
+			flags.bindProtocBinPath(flagSet)
+			flags.bindProtocWKTPath(flagSet)
+		},
+	}
+
+	mvCmdTemplate = &cmdTemplate{
+		Use:   "mv file dirOrFile",
+		Short: "Move a Protobuf file to a directory or file, and to the package for the directory.",
+		Long: `If the destination ends with a "/" or is an existing directory, the file keeps its name and is moved into the directory, which is created if it does not exist.
+
+The package of the moved file is computed from the destination directory in the same way as for "create", and the file options are updated as with "format --fix". The import paths of the file and the references to its types and extensions, including the names of custom options, in all other files of the configuration are updated, and the files are compiled before and after the move. If the files do not compile after the move, the original files are restored.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(runner exec.Runner, args []string, flags *flags) error {
+			return runner.Move(args)
+		},
+		BindFlags: func(flagSet *pflag.FlagSet, flags *flags) {
+			flags.bindCachePath(flagSet)
+			flags.bindConfigData(flagSet)
+			flags.bindErrorFormat(flagSet)
+			flags.bindJSON(flagSet)
 			flags.bindProtocURL(flagSet)
 			flags.bindProtocBinPath(flagSet)
 			flags.bindProtocWKTPath(flagSet)
@@ -589,6 +653,30 @@ func getRunner(develMode bool, stdin io.Reader, stdout io.Writer, stderr io.Writ
 			exec.RunnerWithJSON(),
 		)
 	}
+	if flags.diffMode {
+		runnerOptions = append(
+			runnerOptions,
+			exec.RunnerWithDiffContextLines(flags.diffContextLines),
+		)
+	}
+	if flags.color {
+		runnerOptions = append(
+			runnerOptions,
+			exec.RunnerWithDiffColor(),
+		)
+	}
+	if flags.failOn != "" {
+		runnerOptions = append(
+			runnerOptions,
+			exec.RunnerWithFailOn(flags.failOn),
+		)
+	}
+	if flags.outputFormat != "" {
+		runnerOptions = append(
+			runnerOptions,
+			exec.RunnerWithOutputFormat(flags.outputFormat),
+		)
+	}
 	if flags.protocBinPath != "" {
 		runnerOptions = append(
 			runnerOptions,
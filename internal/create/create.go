@@ -20,7 +20,10 @@
 
 package create
 
-import "go.uber.org/zap"
+import (
+	"github.com/uber/prototool/internal/settings"
+	"go.uber.org/zap"
+)
 
 const (
 	// DefaultPackage is the default package to use in lieu of one being able
@@ -75,6 +78,17 @@ func HandlerWithConfigData(configData string) HandlerOption {
 	}
 }
 
+// GetPackage returns the package for Protobuf files in the given absolute
+// directory path, derived from the create.packages mappings of the config,
+// or from the directory relative to the config directory if no mapping
+// matches. This is the package that Handler uses for new files.
+//
+// If no package can be derived, for example for the config directory
+// itself without a mapping, an empty string is returned.
+func GetPackage(config settings.Config, absDirPath string) (string, error) {
+	return getPackage(config, absDirPath)
+}
+
 // NewHandler returns a new Handler.
 func NewHandler(options ...HandlerOption) Handler {
 	return newHandler(options...)
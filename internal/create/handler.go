@@ -30,6 +30,7 @@ import (
 	"strings"
 	"text/template"
 
+	"github.com/uber/prototool/internal/lint"
 	"github.com/uber/prototool/internal/protostrs"
 	"github.com/uber/prototool/internal/settings"
 	"go.uber.org/zap"
@@ -204,7 +205,7 @@ func (h *handler) isV2(filePath string) (bool, error) {
 	if config.DirPath == "" {
 		return false, nil
 	}
-	return strings.ToLower(config.Lint.Group) == "uber2", nil
+	return lint.GetBuiltinGroup(config.Lint, config.Lint.Group) == "uber2", nil
 }
 
 func (h *handler) getFileHeader(filePath string) (string, error) {
@@ -241,6 +242,21 @@ func (h *handler) getPkg(filePath string, defaultPackage string) (string, error)
 	if config.DirPath == "" {
 		return defaultPackage, nil
 	}
+	pkg, err := getPackage(config, absDirPath)
+	if err != nil {
+		return "", err
+	}
+	if pkg == "" {
+		return defaultPackage, nil
+	}
+	return pkg, nil
+}
+
+func getPackage(config settings.Config, absDirPath string) (string, error) {
+	// no config file found, can't compute package
+	if config.DirPath == "" {
+		return "", nil
+	}
 	// we need to get all the matching directories and then choose the longest
 	// ie if you have a, a/b, we choose a/b
 	var longestCreateDirPath string
@@ -263,7 +279,7 @@ func (h *handler) getPkg(filePath string, defaultPackage string) (string, error)
 		if err != nil {
 			return "", err
 		}
-		return getPkgFromRel(rel, longestBasePkg, defaultPackage), nil
+		return getPkgFromRel(rel, longestBasePkg, ""), nil
 	}
 
 	// no package mapping found, do default logic
@@ -271,13 +287,13 @@ func (h *handler) getPkg(filePath string, defaultPackage string) (string, error)
 	// TODO: cannot do rel right away because it will do ../.. if necessary
 	// strings.HasPrefix is not OS independent however
 	if !strings.HasPrefix(absDirPath, config.DirPath) {
-		return defaultPackage, nil
+		return "", nil
 	}
 	rel, err := filepath.Rel(config.DirPath, absDirPath)
 	if err != nil {
 		return "", err
 	}
-	return getPkgFromRel(rel, "", defaultPackage), nil
+	return getPkgFromRel(rel, "", ""), nil
 }
 
 func (h *handler) getConfig(absDirPath string) (settings.Config, error) {
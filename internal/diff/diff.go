@@ -18,92 +18,181 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-// Primarily adapted from https://github.com/golang/go/blob/e86168430f0aab8f971763e4b00c2aae7bec55f0/src/cmd/gofmt/gofmt.go.
-// Copyright 2009 The Go Authors. All rights reserved.
-// Use of this source code is governed by a BSD-style
-// license that can be found in the LICENSE file.
-
 package diff
 
 import (
 	"bytes"
 	"fmt"
-	"io/ioutil"
-	"os"
-	"os/exec"
 	"path/filepath"
-	"runtime"
 )
 
-// Do does a diff between an input and output.
-func Do(input []byte, output []byte, filename string) ([]byte, error) {
-	f1, err := writeTempFile("", "prototool-diff", input)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = os.Remove(f1) }()
+// DefaultContextLines is the default number of unchanged lines
+// printed around each change, as with diff -u.
+const DefaultContextLines = 3
 
-	f2, err := writeTempFile("", "prototool-diff", output)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = os.Remove(f2) }()
+const (
+	colorBold  = "\x1b[1m"
+	colorCyan  = "\x1b[36m"
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
 
-	cmd := "diff"
-	if runtime.GOOS == "plan9" {
-		cmd = "/bin/ape/diff"
-	}
+// DoOption is an option for Do.
+type DoOption func(*doOptions)
 
-	data, err := exec.Command(cmd, "-u", f1, f2).CombinedOutput()
-	if len(data) > 0 {
-		// diff exits with a non-zero status when the files don't match.
-		// Ignore that failure as long as we get output.
-		return replaceTempFilename(data, filename)
+// DoWithContextLines returns a DoOption that prints the given number
+// of unchanged lines around each change.
+//
+// The default is DefaultContextLines.
+func DoWithContextLines(contextLines int) DoOption {
+	return func(doOptions *doOptions) {
+		doOptions.contextLines = contextLines
 	}
-	return nil, err
 }
 
-func writeTempFile(dir, prefix string, data []byte) (string, error) {
-	file, err := ioutil.TempFile(dir, prefix)
-	if err != nil {
-		return "", err
-	}
-	_, err = file.Write(data)
-	if err1 := file.Close(); err == nil {
-		err = err1
-	}
-	if err != nil {
-		_ = os.Remove(file.Name())
-		return "", err
+// DoWithColor returns a DoOption that colors the diff with ANSI escape
+// codes, with the file headers in bold, the hunk headers in cyan, removed
+// lines in red and added lines in green.
+func DoWithColor() DoOption {
+	return func(doOptions *doOptions) {
+		doOptions.color = true
 	}
-	return file.Name(), nil
 }
 
-// replaceTempFilename replaces temporary filenames in diff with actual one.
+type doOptions struct {
+	contextLines int
+	color        bool
+}
+
+// Do does a diff between an input and output.
+//
+// The diff is in the unified format of diff -u, with the input labeled
+// as filename.orig and the output labeled as filename, and without
+// timestamps. If there are no differences, nil is returned.
 //
-//   --- /tmp/gofmt316145376	2017-02-03 19:13:00.280468375 -0500
-//   +++ /tmp/gofmt617882815	2017-02-03 19:13:00.280468375 -0500
-//   ...
-//   ->
-//   --- path/to/file.go.orig	2017-02-03 19:13:00.280468375 -0500
-//   +++ path/to/file.go	2017-02-03 19:13:00.280468375 -0500
-//   ...
-func replaceTempFilename(diff []byte, filename string) ([]byte, error) {
-	bs := bytes.SplitN(diff, []byte{'\n'}, 3)
-	if len(bs) < 3 {
-		return nil, fmt.Errorf("got unexpected diff for %s", filename)
+// Where there is more than one smallest diff, such as when a line is
+// moved between equal lines, the chosen lines can differ from diff.
+func Do(input []byte, output []byte, filename string, options ...DoOption) ([]byte, error) {
+	doOptions := &doOptions{
+		contextLines: DefaultContextLines,
 	}
-	// Preserve timestamps.
-	var t0, t1 []byte
-	if i := bytes.LastIndexByte(bs[0], '\t'); i != -1 {
-		t0 = bs[0][i:]
+	for _, option := range options {
+		option(doOptions)
 	}
-	if i := bytes.LastIndexByte(bs[1], '\t'); i != -1 {
-		t1 = bs[1][i:]
+	if doOptions.contextLines < 0 {
+		return nil, fmt.Errorf("context lines must be non-negative: %d", doOptions.contextLines)
+	}
+	oldLines := splitLines(input)
+	newLines := splitLines(output)
+	edits := lines(oldLines, newLines, doOptions.contextLines)
+	if len(edits) == 0 {
+		return nil, nil
+	}
+	printer := &unifiedPrinter{
+		buffer: bytes.NewBuffer(nil),
+		color:  doOptions.color,
 	}
 	// Always print filepath with slash separator.
 	f := filepath.ToSlash(filename)
-	bs[0] = []byte(fmt.Sprintf("--- %s%s", f+".orig", t0))
-	bs[1] = []byte(fmt.Sprintf("+++ %s%s", f, t1))
-	return bytes.Join(bs, []byte{'\n'}), nil
+	printer.printLine(colorBold, "--- "+f+".orig\n")
+	printer.printLine(colorBold, "+++ "+f+"\n")
+	for _, hunk := range getHunks(edits, doOptions.contextLines) {
+		first := hunk[0]
+		last := hunk[len(hunk)-1]
+		// the context lines are the same in the old and new lines
+		oldStart := first.OldStart - doOptions.contextLines
+		if oldStart < 0 {
+			oldStart = 0
+		}
+		oldEnd := last.OldEnd + doOptions.contextLines
+		if oldEnd > len(oldLines) {
+			oldEnd = len(oldLines)
+		}
+		newStart := first.NewStart - (first.OldStart - oldStart)
+		newEnd := last.NewEnd + (oldEnd - last.OldEnd)
+		printer.printLine(colorCyan, fmt.Sprintf("@@ -%s +%s @@\n", formatRange(oldStart, oldEnd), formatRange(newStart, newEnd)))
+		i := oldStart
+		for _, edit := range hunk {
+			printer.printLines(" ", "", oldLines[i:edit.OldStart])
+			printer.printLines("-", colorRed, oldLines[edit.OldStart:edit.OldEnd])
+			printer.printLines("+", colorGreen, newLines[edit.NewStart:edit.NewEnd])
+			i = edit.OldEnd
+		}
+		printer.printLines(" ", "", oldLines[i:oldEnd])
+	}
+	return printer.buffer.Bytes(), nil
+}
+
+type unifiedPrinter struct {
+	buffer *bytes.Buffer
+	color  bool
+}
+
+func (p *unifiedPrinter) printLines(prefix string, color string, lines []string) {
+	for _, line := range lines {
+		if line[len(line)-1] == '\n' {
+			p.printLine(color, prefix+line)
+		} else {
+			p.printLine(color, prefix+line+"\n")
+			p.buffer.WriteString("\\ No newline at end of file\n")
+		}
+	}
+}
+
+// printLine prints the line, which must end in a newline, in the color
+// if colors are enabled and the color is not empty.
+func (p *unifiedPrinter) printLine(color string, line string) {
+	if !p.color || color == "" {
+		p.buffer.WriteString(line)
+		return
+	}
+	p.buffer.WriteString(color)
+	p.buffer.WriteString(line[:len(line)-1])
+	p.buffer.WriteString(colorReset)
+	p.buffer.WriteString("\n")
+}
+
+// getHunks groups the edits into hunks, where the edits of a hunk are
+// close enough that their context lines touch or overlap.
+func getHunks(edits []Edit, contextLines int) [][]Edit {
+	var hunks [][]Edit
+	for i, edit := range edits {
+		if i > 0 && edit.OldStart-edits[i-1].OldEnd <= 2*contextLines {
+			hunks[len(hunks)-1] = append(hunks[len(hunks)-1], edit)
+		} else {
+			hunks = append(hunks, []Edit{edit})
+		}
+	}
+	return hunks
+}
+
+// formatRange formats the range [start, end) of line indexes as in the
+// hunk headers of diff -u. Line numbers start at 1, the count is omitted
+// if it is 1, and an empty range is given by the line before it.
+func formatRange(start int, end int) string {
+	switch count := end - start; count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
+
+// splitLines splits the data into lines that keep their newlines, so that
+// a last line without a newline is different from the same line with one.
+func splitLines(data []byte) []string {
+	var lines []string
+	for len(data) > 0 {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			lines = append(lines, string(data))
+			break
+		}
+		lines = append(lines, string(data[:i+1]))
+		data = data[i+1:]
+	}
+	return lines
 }
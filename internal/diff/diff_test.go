@@ -26,6 +26,7 @@
 package diff
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -39,6 +40,80 @@ func TestDo(t *testing.T) {
 	testDo(t, "abc\nabc", "abc\nabc", nil)
 }
 
+func TestDoUnified(t *testing.T) {
+	input := "a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"
+	output := "a\nB\nc\nd\ne\nf\ng\nh\nI\nj\n"
+	testDoUnified(t, input, output, nil,
+		"--- foo.proto.orig",
+		"+++ foo.proto",
+		"@@ -1,10 +1,10 @@",
+		" a",
+		"-b",
+		"+B",
+		" c",
+		" d",
+		" e",
+		" f",
+		" g",
+		" h",
+		"-i",
+		"+I",
+		" j",
+	)
+	testDoUnified(t, input, output, []DoOption{DoWithContextLines(1)},
+		"--- foo.proto.orig",
+		"+++ foo.proto",
+		"@@ -1,3 +1,3 @@",
+		" a",
+		"-b",
+		"+B",
+		" c",
+		"@@ -8,3 +8,3 @@",
+		" h",
+		"-i",
+		"+I",
+		" j",
+	)
+	testDoUnified(t, "a\nb", "a\nb\n", nil,
+		"--- foo.proto.orig",
+		"+++ foo.proto",
+		"@@ -1,2 +1,2 @@",
+		" a",
+		"-b",
+		`\ No newline at end of file`,
+		"+b",
+	)
+	testDoUnified(t, "", "a\n", nil,
+		"--- foo.proto.orig",
+		"+++ foo.proto",
+		"@@ -0,0 +1 @@",
+		"+a",
+	)
+	// an added closing brace is shown after the existing one
+	testDoUnified(t, "a\n}\nb\n", "a\n}\n}\nb\n", []DoOption{DoWithContextLines(0)},
+		"--- foo.proto.orig",
+		"+++ foo.proto",
+		"@@ -2,0 +3 @@",
+		"+}",
+	)
+	testDoUnified(t, "a\nb\n", "a\nc\n", []DoOption{DoWithColor()},
+		"\x1b[1m--- foo.proto.orig\x1b[0m",
+		"\x1b[1m+++ foo.proto\x1b[0m",
+		"\x1b[36m@@ -1,2 +1,2 @@\x1b[0m",
+		" a",
+		"\x1b[31m-b\x1b[0m",
+		"\x1b[32m+c\x1b[0m",
+	)
+	_, err := Do([]byte("a"), []byte("b"), "foo.proto", DoWithContextLines(-1))
+	assert.Error(t, err)
+}
+
+func testDoUnified(t *testing.T, input string, output string, options []DoOption, expectedLines ...string) {
+	diff, err := Do([]byte(input), []byte(output), "foo.proto", options...)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Join(expectedLines, "\n")+"\n", string(diff))
+}
+
 func testDo(
 	t *testing.T,
 	input string,
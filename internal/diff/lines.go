@@ -0,0 +1,280 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package diff
+
+// Edit replaces the lines [OldStart, OldEnd) of the old lines with
+// the lines [NewStart, NewEnd) of the new lines.
+//
+// Line indexes start at 0. If OldStart == OldEnd, this is an insertion
+// before the old line OldStart, and if NewStart == NewEnd, this is
+// a deletion.
+type Edit struct {
+	OldStart int
+	OldEnd   int
+	NewStart int
+	NewEnd   int
+}
+
+// Lines returns the edits that turn the old lines into the new lines,
+// using the Myers diff algorithm.
+//
+// The edits are ordered by position and do not overlap or touch.
+func Lines(oldLines []string, newLines []string) []Edit {
+	return lines(oldLines, newLines, 0)
+}
+
+// lines returns the edits that turn the old lines into the new lines.
+//
+// As with diff, the common prefix and suffix are trimmed except for the
+// given number of horizon lines, and the changes can only be shifted
+// within the remaining lines.
+func lines(oldLines []string, newLines []string, horizon int) []Edit {
+	// trim the common prefix and suffix, as formatting
+	// usually only changes parts of a file
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix &&
+		oldLines[len(oldLines)-1-suffix] == newLines[len(newLines)-1-suffix] {
+		suffix++
+	}
+	prefix -= horizon
+	if prefix < 0 {
+		prefix = 0
+	}
+	suffix -= horizon
+	if suffix < 0 {
+		suffix = 0
+	}
+	oldLines = oldLines[prefix : len(oldLines)-suffix]
+	newLines = newLines[prefix : len(newLines)-suffix]
+	oldChanged := newChanged(len(oldLines))
+	newChanged := newChanged(len(newLines))
+	for _, edit := range myers(oldLines, newLines) {
+		for i := edit.OldStart; i < edit.OldEnd; i++ {
+			oldChanged[i+1] = true
+		}
+		for i := edit.NewStart; i < edit.NewEnd; i++ {
+			newChanged[i+1] = true
+		}
+	}
+	shiftBoundaries(oldLines, oldChanged, newChanged)
+	shiftBoundaries(newLines, newChanged, oldChanged)
+	edits := getEdits(oldChanged, newChanged)
+	for i := range edits {
+		edits[i].OldStart += prefix
+		edits[i].OldEnd += prefix
+		edits[i].NewStart += prefix
+		edits[i].NewEnd += prefix
+	}
+	return edits
+}
+
+// newChanged returns the slice that marks which of the given number of
+// lines are changed. The slice has a false sentinel at each end, so that
+// line i is at index i+1.
+func newChanged(numLines int) []bool {
+	return make([]bool, numLines+2)
+}
+
+// shiftBoundaries slides each run of changed lines up or down over equal
+// lines to merge it with neighbouring runs and, where possible, line it up
+// with a run of changes in the other lines, which is what diff does.
+//
+// Otherwise, a run is moved as far down as possible, so that for example
+// an added closing brace is shown after the existing one.
+//
+// Adapted from shift_boundaries in GNU diffutils.
+func shiftBoundaries(lines []string, changed []bool, otherChanged []bool) {
+	// the indexes are on the changed slices, so that line i is i-1
+	equal := func(i int, j int) bool { return lines[i-1] == lines[j-1] }
+	i := 1
+	j := 1
+	end := len(changed) - 1
+	for {
+		// find the start of the next run of changes, and the
+		// corresponding point in the other lines
+		for i < end && !changed[i] {
+			for otherChanged[j] {
+				j++
+			}
+			j++
+			i++
+		}
+		if i == end {
+			return
+		}
+		start := i
+		for i++; changed[i]; i++ {
+		}
+		for otherChanged[j] {
+			j++
+		}
+		var corresponding int
+		for {
+			runLength := i - start
+			// move the run up while the line before it matches its last
+			// line, merging it with the runs before it
+			for start > 1 && equal(start-1, i-1) {
+				start--
+				changed[start] = true
+				i--
+				changed[i] = false
+				for changed[start-1] {
+					start--
+				}
+				for j--; otherChanged[j]; j-- {
+				}
+			}
+			// the end of the run at the last point where it lines up
+			// with a run of changes in the other lines, if any
+			corresponding = end
+			if otherChanged[j-1] {
+				corresponding = i
+			}
+			// move the run down while the line after it matches its first
+			// line, merging it with the runs after it
+			for i != end && equal(start, i) {
+				changed[start] = false
+				start++
+				changed[i] = true
+				i++
+				for changed[i] {
+					i++
+				}
+				for j++; otherChanged[j]; j++ {
+					corresponding = i
+				}
+			}
+			if runLength == i-start {
+				break
+			}
+		}
+		// move the merged run back up to line up with a run of
+		// changes in the other lines, if possible
+		for corresponding < i {
+			start--
+			changed[start] = true
+			i--
+			changed[i] = false
+			for j--; otherChanged[j]; j-- {
+			}
+		}
+	}
+}
+
+// getEdits returns the edits for the changed lines.
+func getEdits(oldChanged []bool, newChanged []bool) []Edit {
+	var edits []Edit
+	i := 1
+	j := 1
+	for i < len(oldChanged)-1 || j < len(newChanged)-1 {
+		if !oldChanged[i] && !newChanged[j] {
+			i++
+			j++
+			continue
+		}
+		edit := Edit{OldStart: i - 1, NewStart: j - 1}
+		for oldChanged[i] {
+			i++
+		}
+		for newChanged[j] {
+			j++
+		}
+		edit.OldEnd = i - 1
+		edit.NewEnd = j - 1
+		edits = append(edits, edit)
+	}
+	return edits
+}
+
+// myers returns the edits that turn a into b.
+//
+// See "An O(ND) Difference Algorithm and Its Variations" by Eugene W. Myers.
+func myers(a []string, b []string) []*Edit {
+	n := len(a)
+	m := len(b)
+	if n == 0 && m == 0 {
+		return nil
+	}
+	offset := n + m + 1
+	// v[offset+k] is the furthest x reached on diagonal k
+	v := make([]int, 2*offset+1)
+	// trace[d] holds v[offset-d-1:offset+d+2] before step d, which
+	// is all that is needed to backtrack through step d
+	var trace [][]int
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+	// not reached, the end is always found by step n+m
+	return nil
+}
+
+func backtrack(trace [][]int, x int, y int) []*Edit {
+	var edits []*Edit
+	for d := len(trace) - 1; d > 0; d-- {
+		// index k on the snapshot of step d
+		v := func(k int) int { return trace[d][k+d+1] }
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && v(k-1) < v(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v(prevK)
+		prevY := prevX - prevK
+		// skip the equal lines on the diagonal
+		for x > prevX && y > prevY {
+			x--
+			y--
+		}
+		// the single insertion or deletion from (prevX, prevY)
+		if len(edits) > 0 && edits[0].OldStart == x && edits[0].NewStart == y {
+			edits[0].OldStart = prevX
+			edits[0].NewStart = prevY
+		} else {
+			edits = append([]*Edit{{OldStart: prevX, OldEnd: x, NewStart: prevY, NewEnd: y}}, edits...)
+		}
+		x = prevX
+		y = prevY
+	}
+	return edits
+}
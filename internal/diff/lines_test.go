@@ -0,0 +1,76 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package diff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	testLines(t, "", "")
+	testLines(t, "a b c", "a b c")
+	testLines(t, "", "a b", Edit{0, 0, 0, 2})
+	testLines(t, "a b", "", Edit{0, 2, 0, 0})
+	testLines(t, "a b c", "a d c", Edit{1, 2, 1, 2})
+	testLines(t, "a b c", "a b c d", Edit{3, 3, 3, 4})
+	testLines(t, "a b c", "z a b c", Edit{0, 0, 0, 1})
+	testLines(t, "a b c d e", "a c d f e", Edit{1, 2, 1, 1}, Edit{4, 4, 3, 4})
+	testLines(t, "a b c a b b a", "c b a b a c", Edit{0, 2, 0, 0}, Edit{3, 3, 1, 2}, Edit{5, 6, 4, 4}, Edit{7, 7, 5, 6})
+}
+
+func TestLinesApply(t *testing.T) {
+	for _, c := range [][2]string{
+		{"a b c d e f g", "g f e d c b a"},
+		{"a a a b b b", "b b b a a a"},
+		{"x a y b z c", "a b c"},
+		{"a b c", "x a y b z c"},
+	} {
+		oldLines := strings.Fields(c[0])
+		newLines := strings.Fields(c[1])
+		assert.Equal(t, newLines, apply(oldLines, newLines, Lines(oldLines, newLines)), "%v", c)
+	}
+}
+
+func testLines(t *testing.T, oldText string, newText string, expectedEdits ...Edit) {
+	oldLines := strings.Fields(oldText)
+	newLines := strings.Fields(newText)
+	edits := Lines(oldLines, newLines)
+	if len(expectedEdits) == 0 {
+		assert.Empty(t, edits)
+	} else {
+		assert.Equal(t, expectedEdits, edits)
+	}
+	assert.Equal(t, newLines, apply(oldLines, newLines, edits))
+}
+
+func apply(oldLines []string, newLines []string, edits []Edit) []string {
+	result := []string{}
+	last := 0
+	for _, edit := range edits {
+		result = append(result, oldLines[last:edit.OldStart]...)
+		result = append(result, newLines[edit.NewStart:edit.NewEnd]...)
+		last = edit.OldEnd
+	}
+	return append(result, oldLines[last:]...)
+}
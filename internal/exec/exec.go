@@ -47,20 +47,22 @@ func (e *ExitError) Error() string {
 type Runner interface {
 	Init(args []string, uncomment bool) error
 	Create(args []string, pkg string) error
+	Move(args []string) error
 	Version() error
 	CacheUpdate(args []string) error
 	CacheDelete() error
 	Files(args []string) error
 	Compile(args []string, dryRun bool) error
 	Gen(args []string, dryRun bool) error
-	Lint(args []string, listAllLinters bool, listLinters bool, listAllLintGroups bool, listLintGroup string, diffLintGroups string) error
-	Format(args []string, overwrite, diffMode, lintMode, fix bool) error
+	Lint(args []string, listAllLinters bool, listLinters bool, listAllLintGroups bool, listLintGroup string, diffLintGroups string, explain string, fix bool, allowBreakingFixes bool, cache bool) error
+	Format(args []string, overwrite, diffMode, lintMode bool, fix string, stdin, noCompile bool, assumeFilename, lines string) error
 	All(args []string, disableFormat, disableLint, fix bool) error
 	GRPC(args, headers []string, address, method, data, callTimeout, connectTimeout, keepaliveTime string, stdin bool) error
 	InspectPackages(args []string) error
 	InspectPackageDeps(args []string, name string) error
 	InspectPackageImporters(args []string, name string) error
 	BreakCheck(args []string, gitBranch string, gitTag string, includeBeta bool, allowBetaDeps bool) error
+	MigrateProto3(args []string, diffMode bool) error
 }
 
 // RunnerOption is an option for a new Runner.
@@ -111,6 +113,43 @@ func RunnerWithErrorFormat(errorFormat string) RunnerOption {
 	}
 }
 
+// RunnerWithOutputFormat returns a RunnerOption that will print the complete list
+// of failures in the given output format instead of using the error format.
+//
+// Valid values are sarif, checkstyle and junit.
+func RunnerWithOutputFormat(outputFormat string) RunnerOption {
+	return func(runner *runner) {
+		runner.outputFormat = outputFormat
+	}
+}
+
+// RunnerWithFailOn returns a RunnerOption that will only exit with a non-zero
+// exit code for lint failures with a severity greater than or equal to the
+// given severity. The default is error.
+func RunnerWithFailOn(failOn string) RunnerOption {
+	return func(runner *runner) {
+		runner.failOn = failOn
+	}
+}
+
+// RunnerWithDiffContextLines returns a RunnerOption that prints the given
+// number of unchanged lines around each change in diffs.
+//
+// The default is diff.DefaultContextLines.
+func RunnerWithDiffContextLines(diffContextLines int) RunnerOption {
+	return func(runner *runner) {
+		runner.diffContextLines = diffContextLines
+	}
+}
+
+// RunnerWithDiffColor returns a RunnerOption that colors diffs
+// with ANSI escape codes.
+func RunnerWithDiffColor() RunnerOption {
+	return func(runner *runner) {
+		runner.diffColor = true
+	}
+}
+
 // RunnerWithProtocBinPath returns a RunnerOption that uses the given protoc binary path.
 func RunnerWithProtocBinPath(protocBinPath string) RunnerOption {
 	return func(runner *runner) {
@@ -30,13 +30,16 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"text/scanner"
 	"text/tabwriter"
 	"time"
 
+	"github.com/emicklei/proto"
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/uber/prototool/internal/breaking"
 	"github.com/uber/prototool/internal/cfginit"
@@ -48,14 +51,27 @@ import (
 	"github.com/uber/prototool/internal/git"
 	"github.com/uber/prototool/internal/grpc"
 	"github.com/uber/prototool/internal/lint"
+	"github.com/uber/prototool/internal/migrate"
+	"github.com/uber/prototool/internal/move"
 	"github.com/uber/prototool/internal/protoc"
 	"github.com/uber/prototool/internal/reflect"
+	"github.com/uber/prototool/internal/report"
 	"github.com/uber/prototool/internal/settings"
 	"github.com/uber/prototool/internal/text"
 	"github.com/uber/prototool/internal/vars"
+	"github.com/uber/prototool/internal/wkt"
 	"go.uber.org/zap"
 )
 
+const formatDiffID = "FORMAT_DIFF"
+
+var (
+	// the messages of the protoc failures that format --fix can fix by updating imports
+	unusedImportMessageRegexp = regexp.MustCompile(`^Import "(.*)" was not used\.$`)
+	notDefinedMessageRegexp   = regexp.MustCompile(`^"(.*)" is not defined\.$`)
+	notImportedMessageRegexp  = regexp.MustCompile(`^".*" seems to be defined in "(.*)", which is not imported by`)
+)
+
 type runner struct {
 	protoSetProvider file.ProtoSetProvider
 
@@ -71,14 +87,24 @@ type runner struct {
 	protocWKTPath string
 	protocURL     string
 	errorFormat   string
+	failOn        string
+	outputFormat  string
 	json          bool
+
+	diffContextLines int
+	diffColor        bool
+
+	// set by withReport if outputFormat is set, failures will be
+	// accumulated here instead of printed
+	reportFailures *[]*text.Failure
 }
 
 func newRunner(workDirPath string, input io.Reader, output io.Writer, options ...RunnerOption) *runner {
 	runner := &runner{
-		workDirPath: workDirPath,
-		input:       input,
-		output:      output,
+		workDirPath:      workDirPath,
+		input:            input,
+		output:           output,
+		diffContextLines: diff.DefaultContextLines,
 	}
 	for _, option := range options {
 		option(runner)
@@ -115,7 +141,10 @@ func (r *runner) cloneForWorkDirPath(workDirPath string) *runner {
 		protocWKTPath:    r.protocWKTPath,
 		protocURL:        r.protocURL,
 		errorFormat:      r.errorFormat,
+		failOn:           r.failOn,
+		outputFormat:     r.outputFormat,
 		json:             r.json,
+		reportFailures:   r.reportFailures,
 	}
 }
 
@@ -183,6 +212,261 @@ func (r *runner) Create(args []string, pkg string) error {
 	return r.newCreateHandler(pkg).Create(args...)
 }
 
+func (r *runner) Move(args []string) error {
+	if len(args) != 2 {
+		return newExitErrorf(255, "must set the file to move and the destination")
+	}
+	path, err := file.AbsClean(args[0])
+	if err != nil {
+		return err
+	}
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fileInfo.Mode().IsRegular() {
+		return newExitErrorf(255, "%s is not a file", args[0])
+	}
+	newPath, err := getMoveNewPath(path, args[1])
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("%s already exists", newPath)
+	}
+	meta, err := r.getMeta([]string{filepath.Dir(path)})
+	if err != nil {
+		return err
+	}
+	// compile all the files for the config, as the ProtoSet has all the
+	// files for the config and all files that import the moved file are updated
+	if meta.ProtoSet.Config.DirPath != "" {
+		meta, err = r.getMeta([]string{meta.ProtoSet.Config.DirPath})
+		if err != nil {
+			return err
+		}
+	}
+	r.printAffectedFiles(meta)
+	if _, err := r.compile(false, false, false, meta); err != nil {
+		return err
+	}
+	newPackage, err := create.GetPackage(meta.ProtoSet.Config, filepath.Dir(newPath))
+	if err != nil {
+		return err
+	}
+	newPathToData, err := r.newMover().Move(meta.ProtoSet, &move.Move{
+		Path:       path,
+		NewPath:    newPath,
+		NewPackage: newPackage,
+	})
+	if err != nil {
+		return err
+	}
+	// update the file options for the new package and filename
+	data, failures, err := r.newTransformer(getFormatFixValue(true, meta), getFormatFileHeaderValue(true, meta), nil, meta.ProtoSet.Config.Format).Transform(newPath, newPathToData[newPath])
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		if err := r.printFailures(args[0], meta, failures...); err != nil {
+			return err
+		}
+		return newExitErrorf(255, "")
+	}
+	newPathToData[newPath] = data
+	// the original files are restored if the move fails from here on, so
+	// that a failed move does not leave a half moved tree
+	originalPaths := []string{path}
+	for changedPath := range newPathToData {
+		originalPaths = append(originalPaths, changedPath)
+	}
+	pathToOriginalFile, err := readOriginalFiles(originalPaths)
+	if err != nil {
+		return err
+	}
+	createdDirPaths := getMissingDirPaths(filepath.Dir(newPath))
+	if err := r.writeMove(path, newPathToData, pathToOriginalFile, fileInfo.Mode(), meta.ProtoSet.DirPath); err != nil {
+		if restoreErr := restoreMove(pathToOriginalFile, newPathToData, createdDirPaths); restoreErr != nil {
+			return fmt.Errorf("%v, and could not restore the original files: %v", err, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// writeMove writes the files of a move and removes the moved file at path,
+// and then makes sure that the files in dirPath still compile.
+//
+// New files are written with the mode of the moved file.
+func (r *runner) writeMove(path string, newPathToData map[string][]byte, pathToOriginalFile map[string]*originalFile, mode os.FileMode, dirPath string) error {
+	for newPath := range newPathToData {
+		if err := os.MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+			return err
+		}
+	}
+	if err := writeFiles(newPathToData, pathToOriginalFile, mode); err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+	meta, err := r.getMeta([]string{dirPath})
+	if err != nil {
+		return err
+	}
+	_, err = r.compile(false, false, false, meta)
+	return err
+}
+
+// restoreMove undoes writeMove, restoring the original files and removing
+// the directories that were created.
+func restoreMove(pathToOriginalFile map[string]*originalFile, newPathToData map[string][]byte, createdDirPaths []string) error {
+	if err := restoreFiles(pathToOriginalFile, newPathToData); err != nil {
+		return err
+	}
+	for _, dirPath := range createdDirPaths {
+		if err := os.Remove(dirPath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// originalFile is the contents and mode of a file before it was overwritten.
+type originalFile struct {
+	data []byte
+	mode os.FileMode
+}
+
+// readOriginalFiles reads the contents and modes of the files at the given
+// paths so that they can be restored with restoreFiles.
+//
+// Paths that do not exist are skipped.
+func readOriginalFiles(paths []string) (map[string]*originalFile, error) {
+	pathToOriginalFile := make(map[string]*originalFile, len(paths))
+	for _, path := range paths {
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		pathToOriginalFile[path] = &originalFile{
+			data: data,
+			mode: fileInfo.Mode(),
+		}
+	}
+	return pathToOriginalFile, nil
+}
+
+// writeFiles writes the files in pathToData, keeping the modes of the
+// original files. Files that did not exist are written with mode.
+func writeFiles(pathToData map[string][]byte, pathToOriginalFile map[string]*originalFile, mode os.FileMode) error {
+	for path, data := range pathToData {
+		fileMode := mode
+		if originalFile, ok := pathToOriginalFile[path]; ok {
+			fileMode = originalFile.mode
+		}
+		if err := ioutil.WriteFile(path, data, fileMode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreFiles restores the original contents and modes of the files and
+// removes the files in pathToData that did not exist.
+func restoreFiles(pathToOriginalFile map[string]*originalFile, pathToData map[string][]byte) error {
+	for path := range pathToData {
+		if _, ok := pathToOriginalFile[path]; ok {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for path, originalFile := range pathToOriginalFile {
+		if err := ioutil.WriteFile(path, originalFile.data, originalFile.mode); err != nil {
+			return err
+		}
+		// the file may have been removed and recreated with the umask applied
+		if err := os.Chmod(path, originalFile.mode); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeFilesOrRestore writes the files in pathToData, keeping the modes of
+// the original files, and then calls check. If writing the files or check
+// fails, the original files are restored, so that a failure does not leave
+// a half written tree.
+func writeFilesOrRestore(pathToData map[string][]byte, check func() error) error {
+	paths := make([]string, 0, len(pathToData))
+	for path := range pathToData {
+		paths = append(paths, path)
+	}
+	pathToOriginalFile, err := readOriginalFiles(paths)
+	if err != nil {
+		return err
+	}
+	err = writeFiles(pathToData, pathToOriginalFile, 0644)
+	if err == nil {
+		err = check()
+	}
+	if err != nil {
+		if restoreErr := restoreFiles(pathToOriginalFile, pathToData); restoreErr != nil {
+			return fmt.Errorf("%v, and could not restore the original files: %v", err, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// getMissingDirPaths returns the directories from dirPath up that do not
+// exist, innermost first.
+func getMissingDirPaths(dirPath string) []string {
+	var missingDirPaths []string
+	for {
+		if _, err := os.Stat(dirPath); err == nil {
+			return missingDirPaths
+		}
+		missingDirPaths = append(missingDirPaths, dirPath)
+		parentDirPath := filepath.Dir(dirPath)
+		if parentDirPath == dirPath {
+			return missingDirPaths
+		}
+		dirPath = parentDirPath
+	}
+}
+
+// getMoveNewPath returns the absolute path to move the file at path to.
+//
+// If the destination ends with a separator or is an existing directory, the
+// file keeps its name in the directory.
+func getMoveNewPath(path string, destination string) (string, error) {
+	isDir := strings.HasSuffix(destination, "/") || strings.HasSuffix(destination, string(os.PathSeparator))
+	if fileInfo, err := os.Stat(destination); err == nil && fileInfo.IsDir() {
+		isDir = true
+	}
+	newPath, err := file.AbsClean(destination)
+	if err != nil {
+		return "", err
+	}
+	if isDir {
+		newPath = filepath.Join(newPath, filepath.Base(path))
+	}
+	if filepath.Ext(newPath) != ".proto" {
+		return "", newExitErrorf(255, "%s is not a .proto file", destination)
+	}
+	return newPath, nil
+}
+
 func (r *runner) CacheUpdate(args []string) error {
 	meta, err := r.getMeta(args)
 	if err != nil {
@@ -229,13 +513,18 @@ func (r *runner) Files(args []string) error {
 }
 
 func (r *runner) Compile(args []string, dryRun bool) error {
+	if dryRun && r.outputFormat != "" {
+		return newExitErrorf(255, "can only set one of dry-run, output-format")
+	}
 	meta, err := r.getMeta(args)
 	if err != nil {
 		return err
 	}
 	r.printAffectedFiles(meta)
-	_, err = r.compile(false, false, dryRun, meta)
-	return err
+	return r.withReport(meta.ProtoSet.Config.Lint, func() error {
+		_, err := r.compile(false, false, dryRun, meta)
+		return err
+	})
 }
 
 func (r *runner) Gen(args []string, dryRun bool) error {
@@ -252,7 +541,89 @@ func (r *runner) compile(doGen, doFileDescriptorSet, dryRun bool, meta *meta) ([
 	if dryRun {
 		return nil, r.printCommands(doGen, meta.ProtoSet)
 	}
-	compileResult, err := r.newCompiler(doGen, doFileDescriptorSet).Compile(meta.ProtoSet)
+	return r.compileWithCompiler(r.newCompiler(doGen, doFileDescriptorSet, false), meta)
+}
+
+// compileForLint compiles the files before they are linted, and returns
+// the FileDescriptorSets with source code info if any enabled linter
+// checks the compiled descriptors.
+func (r *runner) compileForLint(meta *meta) ([]*descriptor.FileDescriptorSet, error) {
+	needsFileDescriptorSets, err := r.lintNeedsFileDescriptorSets(meta)
+	if err != nil {
+		return nil, err
+	}
+	if !needsFileDescriptorSets {
+		_, err := r.compile(false, false, false, meta)
+		return nil, err
+	}
+	return r.compileWithCompiler(r.newCompiler(false, true, true), meta)
+}
+
+// compileForFormat compiles the files before they are formatted.
+//
+// If fix is set, the imports that protoc reports as unused, and the imports
+// of the files that define referenced types that are not imported, are
+// returned by file path so that they are fixed by format instead of failing.
+// This is only done for the files that are formatted, as the failures of
+// the other files would not be fixed.
+func (r *runner) compileForFormat(fix int, meta *meta) (map[string]*formatImportFix, error) {
+	if fix == format.FixNone {
+		_, err := r.compile(false, false, false, meta)
+		return nil, err
+	}
+	absSingleFilename, err := file.AbsClean(meta.SingleFilename)
+	if err != nil {
+		return nil, err
+	}
+	compileResult, err := r.newCompiler(false, false, false).Compile(meta.ProtoSet)
+	if err != nil {
+		return nil, err
+	}
+	importResolver := newFormatImportResolver(meta.ProtoSet)
+	importFixes := make(map[string]*formatImportFix)
+	var failures []*text.Failure
+	for _, failure := range compileResult.Failures {
+		protoFile := getProtoFileForDisplayPath(meta.ProtoSet, failure.Filename)
+		if protoFile == nil || !isFormattedFile(meta, absSingleFilename, protoFile) {
+			failures = append(failures, failure)
+			continue
+		}
+		if matches := unusedImportMessageRegexp.FindStringSubmatch(failure.Message); len(matches) > 1 {
+			importFix := getFormatImportFix(importFixes, protoFile.Path)
+			importFix.removeImports = append(importFix.removeImports, matches[1])
+			continue
+		}
+		importFilename, err := importResolver.getImportFilename(protoFile, failure.Message)
+		if err != nil {
+			return nil, err
+		}
+		if importFilename != "" {
+			importFix := getFormatImportFix(importFixes, protoFile.Path)
+			importFix.addImports = append(importFix.addImports, importFilename)
+			continue
+		}
+		failures = append(failures, failure)
+	}
+	if err := r.printFailures("", meta, failures...); err != nil {
+		return nil, err
+	}
+	if len(failures) > 0 {
+		return nil, newExitErrorf(255, "")
+	}
+	r.logger.Debug("protoc command exited without errors that cannot be fixed by format")
+	return importFixes, nil
+}
+
+func (r *runner) lintNeedsFileDescriptorSets(meta *meta) (bool, error) {
+	linters, err := lint.GetLinters(meta.ProtoSet.Config.Lint)
+	if err != nil {
+		return false, err
+	}
+	return lint.NeedsFileDescriptorSets(linters), nil
+}
+
+func (r *runner) compileWithCompiler(compiler protoc.Compiler, meta *meta) ([]*descriptor.FileDescriptorSet, error) {
+	compileResult, err := compiler.Compile(meta.ProtoSet)
 	if err != nil {
 		return nil, err
 	}
@@ -267,7 +638,7 @@ func (r *runner) compile(doGen, doFileDescriptorSet, dryRun bool, meta *meta) ([
 }
 
 func (r *runner) printCommands(doGen bool, protoSet *file.ProtoSet) error {
-	commands, err := r.newCompiler(doGen, false).ProtocCommands(protoSet)
+	commands, err := r.newCompiler(doGen, false, false).ProtocCommands(protoSet)
 	if err != nil {
 		return err
 	}
@@ -279,15 +650,34 @@ func (r *runner) printCommands(doGen bool, protoSet *file.ProtoSet) error {
 	return nil
 }
 
-func (r *runner) Lint(args []string, listAllLinters bool, listLinters bool, listAllLintGroups bool, listLintGroup string, diffLintGroups string) error {
-	if moreThanOneSet(listAllLinters, listLinters, listAllLintGroups, listLintGroup != "", diffLintGroups != "") {
-		return newExitErrorf(255, "can only set one of list-all-linters, list-linters, list-all-lint-groups, list-lint-group, diff-lint-groups")
+func (r *runner) Lint(args []string, listAllLinters bool, listLinters bool, listAllLintGroups bool, listLintGroup string, diffLintGroups string, explain string, fix bool, allowBreakingFixes bool, cache bool) error {
+	if moreThanOneSet(listAllLinters, listLinters, listAllLintGroups, listLintGroup != "", diffLintGroups != "", explain != "", fix) {
+		return newExitErrorf(255, "can only set one of list-all-linters, list-linters, list-all-lint-groups, list-lint-group, diff-lint-groups, explain, fix")
 	}
-	if listAllLintGroups {
-		return r.listAllLintGroups()
+	if allowBreakingFixes && !fix {
+		return newExitErrorf(255, "can only set allow-breaking-fixes with fix")
 	}
-	if diffLintGroups != "" {
-		return r.diffLintGroups(diffLintGroups)
+	if cache && fix {
+		return newExitErrorf(255, "can only set one of cache, fix")
+	}
+	if listAllLintGroups || diffLintGroups != "" || explain != "" {
+		// the built-in lint groups and linters do not need a config, so only
+		// read one for user-defined lint groups if an argument is given
+		var lintConfig settings.LintConfig
+		if len(args) > 0 {
+			meta, err := r.getMeta(args)
+			if err != nil {
+				return err
+			}
+			lintConfig = meta.ProtoSet.Config.Lint
+		}
+		if listAllLintGroups {
+			return r.listAllLintGroups(lintConfig)
+		}
+		if explain != "" {
+			return r.explainLinter(lintConfig, explain)
+		}
+		return r.diffLintGroups(lintConfig, diffLintGroups)
 	}
 	meta, err := r.getMeta(args)
 	if err != nil {
@@ -303,22 +693,96 @@ func (r *runner) Lint(args []string, listAllLinters bool, listLinters bool, list
 		return r.listLintGroup(meta, listLintGroup)
 	}
 	r.printAffectedFiles(meta)
-	if _, err := r.compile(false, false, false, meta); err != nil {
+	return r.withReport(meta.ProtoSet.Config.Lint, func() error {
+		lintRunner, err := r.newLintRunner(cache, nil)
+		if err != nil {
+			return err
+		}
+		// if the failures for all files are cached, the files compiled
+		// when the failures were cached and do not need to be compiled again
+		failures, ok, err := lintRunner.GetCachedFailures(meta.ProtoSet)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return r.printLintFailures(meta, failures)
+		}
+		fileDescriptorSets, err := r.compileForLint(meta)
+		if err != nil {
+			return err
+		}
+		if fix {
+			return r.lintFix(meta, allowBreakingFixes, fileDescriptorSets)
+		}
+		lintRunner, err = r.newLintRunner(cache, fileDescriptorSets)
+		if err != nil {
+			return err
+		}
+		return r.lint(meta, lintRunner)
+	})
+}
+
+func (r *runner) lint(meta *meta, lintRunner lint.Runner) error {
+	r.logger.Debug("calling LintRunner")
+	failures, err := lintRunner.Run(meta.ProtoSet)
+	if err != nil {
 		return err
 	}
-	return r.lint(meta)
+	return r.printLintFailures(meta, failures)
 }
 
-func (r *runner) lint(meta *meta) error {
+// lintFix fixes the files and then lints them. The FileDescriptorSets are
+// those of the files before they were fixed, if any.
+func (r *runner) lintFix(meta *meta, allowBreakingFixes bool, fileDescriptorSets []*descriptor.FileDescriptorSet) error {
+	r.logger.Debug("calling Fixer")
+	pathToData, fixFailures, err := r.newLintFixer(allowBreakingFixes).Fix(meta.ProtoSet)
+	if err != nil {
+		return err
+	}
+	if len(pathToData) > 0 {
+		// make sure the fixed files still compile, and restore the
+		// original files if they do not
+		if err := writeFilesOrRestore(pathToData, func() error {
+			var err error
+			fileDescriptorSets, err = r.compileForLint(meta)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	lintRunner, err := r.newLintRunner(false, fileDescriptorSets)
+	if err != nil {
+		return err
+	}
 	r.logger.Debug("calling LintRunner")
-	failures, err := r.newLintRunner().Run(meta.ProtoSet)
+	failures, err := lintRunner.Run(meta.ProtoSet)
+	if err != nil {
+		return err
+	}
+	// the failures for fixes that were not applied replace the
+	// corresponding lint failures, as they explain why there was no fix
+	fixFailureKeys := make(map[string]struct{}, len(fixFailures))
+	for _, failure := range fixFailures {
+		fixFailureKeys[getFailureKey(failure)] = struct{}{}
+	}
+	for _, failure := range failures {
+		if _, ok := fixFailureKeys[getFailureKey(failure)]; !ok {
+			fixFailures = append(fixFailures, failure)
+		}
+	}
+	text.SortFailures(fixFailures)
+	return r.printLintFailures(meta, fixFailures)
+}
+
+func (r *runner) printLintFailures(meta *meta, failures []*text.Failure) error {
+	failOn, err := text.ParseSeverity(r.failOn)
 	if err != nil {
 		return err
 	}
 	if err := r.printFailures("", meta, failures...); err != nil {
 		return err
 	}
-	if len(failures) > 0 {
+	if text.CountFailuresWithSeverityAtLeast(failures, failOn) > 0 {
 		return newExitErrorf(255, "")
 	}
 	return nil
@@ -337,20 +801,48 @@ func (r *runner) listAllLinters(meta *meta) error {
 }
 
 func (r *runner) listLintGroup(meta *meta, group string) error {
-	linters, ok := lint.GroupToLinters[strings.ToLower(group)]
-	if !ok {
-		return newExitErrorf(255, "unknown lint group: %s", strings.ToLower(group))
+	linters, err := getGroupLinters(meta.ProtoSet.Config.Lint, group)
+	if err != nil {
+		return err
 	}
 	return r.printLinters(meta.ProtoSet.Config.Lint, linters)
 }
 
-func (r *runner) listAllLintGroups() error {
-	groups := make([]string, 0, len(lint.GroupToLinters))
-	for group := range lint.GroupToLinters {
-		groups = append(groups, group)
+func (r *runner) explainLinter(lintConfig settings.LintConfig, id string) error {
+	linter, ok := lint.GetLinter(id)
+	if !ok {
+		return newExitErrorf(255, "unknown lint id: %s", id)
+	}
+	out := newLinterJSON(lintConfig, linter)
+	if r.json {
+		enc := json.NewEncoder(r.output)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	}
+	if err := r.println(out.ID + "\n\n" + out.Purpose); err != nil {
+		return err
 	}
-	sort.Strings(groups)
-	for _, group := range groups {
+	for _, section := range []struct {
+		title string
+		value string
+	}{
+		{"Default severity", out.DefaultSeverity},
+		{"Rationale", out.Rationale},
+		{"Bad example", out.BadExample},
+		{"Good example", out.GoodExample},
+	} {
+		if section.value == "" {
+			continue
+		}
+		if err := r.println("\n" + section.title + ":\n\n" + section.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *runner) listAllLintGroups(lintConfig settings.LintConfig) error {
+	for _, group := range lint.GetGroups(lintConfig) {
 		if err := r.println(group); err != nil {
 			return err
 		}
@@ -358,16 +850,16 @@ func (r *runner) listAllLintGroups() error {
 	return nil
 }
 
-func (r *runner) diffLintGroups(groups string) error {
+func (r *runner) diffLintGroups(lintConfig settings.LintConfig, groups string) error {
 	split := strings.Split(groups, ",")
 	if len(split) != 2 {
 		return fmt.Errorf("argument to --diff-lint-groups must be two lint groups separated by '.', for example google,uber2")
 	}
-	firstLinterIDs, err := getLinterIDs(split[0])
+	firstLinterIDs, err := getLinterIDs(lintConfig, split[0])
 	if err != nil {
 		return err
 	}
-	secondLinterIDs, err := getLinterIDs(split[1])
+	secondLinterIDs, err := getLinterIDs(lintConfig, split[1])
 	if err != nil {
 		return err
 	}
@@ -379,30 +871,215 @@ func (r *runner) diffLintGroups(groups string) error {
 	return nil
 }
 
-func (r *runner) Format(args []string, overwrite, diffMode, lintMode, fixFlag bool) error {
+func (r *runner) Format(args []string, overwrite, diffMode, lintMode bool, fix string, stdin, noCompile bool, assumeFilename, lines string) error {
+	fixFlag, fixPackage, err := parseFormatFix(fix)
+	if err != nil {
+		return err
+	}
 	if moreThanOneSet(overwrite, diffMode, lintMode) {
 		return newExitErrorf(255, "can only set one of overwrite, diff, lint")
 	}
+	if !lintMode && r.outputFormat != "" {
+		return newExitErrorf(255, "can only set output-format with lint")
+	}
+	if r.diffContextLines < 0 {
+		return newExitErrorf(255, "diff-context-lines must be non-negative: %d", r.diffContextLines)
+	}
+	if stdin {
+		if fixPackage {
+			return newExitErrorf(255, "cannot set fix to package with stdin")
+		}
+		return r.formatStdin(args, overwrite, diffMode, lintMode, fixFlag, assumeFilename, lines)
+	}
+	if assumeFilename != "" {
+		return newExitErrorf(255, "can only set assume-filename with stdin")
+	}
+	if lines != "" {
+		return newExitErrorf(255, "can only set lines with stdin")
+	}
 	meta, err := r.getMeta(args)
 	if err != nil {
 		return err
 	}
 	r.printAffectedFiles(meta)
-	if _, err := r.compile(false, false, false, meta); err != nil {
+	return r.withReport(meta.ProtoSet.Config.Lint, func() error {
+		fix := getFormatFixValue(fixFlag, meta)
+		// without compiling, imports are not fixed and parse errors are
+		// returned as failures from formatting
+		var importFixes map[string]*formatImportFix
+		if !noCompile {
+			importFixes, err = r.compileForFormat(fix, meta)
+			if err != nil {
+				return err
+			}
+		}
+		var pathToPackageFixData map[string][]byte
+		if fixPackage {
+			pathToPackageFixData, err = r.getFormatPackageFixes(meta)
+			if err != nil {
+				return err
+			}
+		}
+		if err := r.format(overwrite, diffMode, lintMode, fix, getFormatFileHeaderValue(fixFlag, meta), importFixes, pathToPackageFixData, meta); err != nil {
+			return err
+		}
+		if overwrite && (len(importFixes) > 0 || len(pathToPackageFixData) > 0) {
+			// make sure the fixed imports are correct, as an import may
+			// have been added for the wrong one of several candidate files
+			if _, err := r.compile(false, false, false, meta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// getFormatPackageFixes returns the contents of the files after moving the
+// files being formatted to the package for their directory, keyed by path.
+//
+// This includes the files that import or reference the moved files, which
+// may not be the files being formatted.
+func (r *runner) getFormatPackageFixes(meta *meta) (map[string][]byte, error) {
+	absSingleFilename, err := file.AbsClean(meta.SingleFilename)
+	if err != nil {
+		return nil, err
+	}
+	var moves []*move.Move
+	for dirPath, protoFiles := range meta.ProtoSet.DirPathToFiles {
+		// skip those files not under the directory
+		if !strings.HasPrefix(dirPath, meta.ProtoSet.DirPath) {
+			continue
+		}
+		pkg, err := create.GetPackage(meta.ProtoSet.Config, dirPath)
+		if err != nil {
+			return nil, err
+		}
+		// no package can be derived for the directory
+		if pkg == "" {
+			continue
+		}
+		for _, protoFile := range protoFiles {
+			// we are not concerned with the current file
+			if meta.SingleFilename != "" && protoFile.Path != absSingleFilename {
+				continue
+			}
+			moves = append(moves, &move.Move{
+				Path:       protoFile.Path,
+				NewPath:    protoFile.Path,
+				NewPackage: pkg,
+			})
+		}
+	}
+	return r.newMover().Move(meta.ProtoSet, moves...)
+}
+
+// parseFormatFix parses the value of the format fix flag, and returns
+// whether to fix the files and whether to also fix the packages.
+func parseFormatFix(fix string) (bool, bool, error) {
+	switch fix {
+	case "", "false":
+		return false, false, nil
+	case "true":
+		return true, false, nil
+	case "package":
+		return true, true, nil
+	default:
+		return false, false, newExitErrorf(255, "fix must be true, false or package: %q", fix)
+	}
+}
+
+// formatStdin formats the file read from stdin as if it was at assumeFilename
+// and writes the result to stdout.
+//
+// The file is not compiled with protoc, as the contents of stdin are not on disk,
+// so imports are not fixed.
+func (r *runner) formatStdin(args []string, overwrite, diffMode, lintMode, fixFlag bool, assumeFilename, lines string) error {
+	if len(args) > 0 {
+		return newExitErrorf(255, "cannot set a directory or file with stdin")
+	}
+	if overwrite {
+		return newExitErrorf(255, "cannot set overwrite with stdin")
+	}
+	if assumeFilename == "" {
+		return newExitErrorf(255, "must set assume-filename with stdin")
+	}
+	startLine, endLine, err := parseLineRange(lines)
+	if err != nil {
+		return err
+	}
+	path, err := file.AbsClean(assumeFilename)
+	if err != nil {
 		return err
 	}
-	return r.format(overwrite, diffMode, lintMode, getFormatFixValue(fixFlag, meta), getFormatFileHeaderValue(fixFlag, meta), meta)
+	// the configuration is resolved by the directory of the assumed file
+	meta, err := r.getMeta([]string{filepath.Dir(assumeFilename)})
+	if err != nil {
+		return err
+	}
+	meta.SingleFilename = assumeFilename
+	input, err := ioutil.ReadAll(r.input)
+	if err != nil {
+		return err
+	}
+	return r.withReport(meta.ProtoSet.Config.Lint, func() error {
+		data, failures, err := r.newTransformer(getFormatFixValue(fixFlag, meta), getFormatFileHeaderValue(fixFlag, meta), nil, meta.ProtoSet.Config.Format).Transform(path, input)
+		if err != nil {
+			return err
+		}
+		if len(failures) > 0 {
+			if err := r.printFailures(assumeFilename, meta, failures...); err != nil {
+				return err
+			}
+			return newExitErrorf(255, "")
+		}
+		if startLine > 0 {
+			data = formatLineRange(input, data, startLine, endLine)
+		}
+		if bytes.Equal(input, data) && (diffMode || lintMode) {
+			return nil
+		}
+		switch {
+		case lintMode:
+			if err := r.printFailures("", meta, text.NewFailuref(scanner.Position{
+				Filename: assumeFilename,
+			}, formatDiffID, "Format returned a diff.")); err != nil {
+				return err
+			}
+			return newExitErrorf(255, "")
+		case diffMode:
+			d, err := r.diff(input, data, assumeFilename)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(r.output, bytes.NewReader(d)); err != nil {
+				return err
+			}
+			return newExitErrorf(255, "")
+		default:
+			// editors replace their buffer with the output, so unlike
+			// formatting files, a diff is not an error
+			_, err := io.Copy(r.output, bytes.NewReader(data))
+			return err
+		}
+	})
 }
 
-func (r *runner) format(overwrite, diffMode, lintMode bool, fix int, fileHeader string, meta *meta) error {
+func (r *runner) format(overwrite, diffMode, lintMode bool, fix int, fileHeader string, importFixes map[string]*formatImportFix, pathToPackageFixData map[string][]byte, meta *meta) error {
+	absSingleFilename, err := file.AbsClean(meta.SingleFilename)
+	if err != nil {
+		return err
+	}
 	success := true
-	for dirPath, protoFiles := range meta.ProtoSet.DirPathToFiles {
-		// skip those files not under the directory
-		if !strings.HasPrefix(dirPath, meta.ProtoSet.DirPath) {
-			continue
-		}
+	for _, protoFiles := range meta.ProtoSet.DirPathToFiles {
 		for _, protoFile := range protoFiles {
-			fileSuccess, err := r.formatFile(overwrite, diffMode, lintMode, fix, fileHeader, meta, protoFile)
+			packageFixData, hasPackageFix := pathToPackageFixData[protoFile.Path]
+			// skip those files not under the directory and the files we are
+			// not concerned with, unless the package fixes change them
+			isFormatted := isFormattedFile(meta, absSingleFilename, protoFile)
+			if !isFormatted && !hasPackageFix {
+				continue
+			}
+			fileSuccess, err := r.formatFile(overwrite, diffMode, lintMode, fix, fileHeader, importFixes[protoFile.Path], packageFixData, !isFormatted, meta, protoFile)
 			if err != nil {
 				return err
 			}
@@ -417,28 +1094,37 @@ func (r *runner) format(overwrite, diffMode, lintMode bool, fix int, fileHeader
 	return nil
 }
 
+// isFormattedFile returns true if the file is under the directory being
+// formatted and is the single file being formatted, if any.
+func isFormattedFile(meta *meta, absSingleFilename string, protoFile *file.ProtoFile) bool {
+	return strings.HasPrefix(filepath.Dir(protoFile.Path), meta.ProtoSet.DirPath) && (meta.SingleFilename == "" || protoFile.Path == absSingleFilename)
+}
+
 // return true if there was no unexpected diff and we should exit with 0
 // return false if we should exit with non-zero
 // if false and nil error, we will return an ExitError outside of this function
-func (r *runner) formatFile(overwrite bool, diffMode bool, lintMode bool, fix int, fileHeader string, meta *meta, protoFile *file.ProtoFile) (bool, error) {
-	absSingleFilename, err := file.AbsClean(meta.SingleFilename)
-	if err != nil {
-		return false, err
-	}
-	// we are not concerned with the current file
-	if meta.SingleFilename != "" && protoFile.Path != absSingleFilename {
-		return true, nil
-	}
+//
+// if packageFixData is not nil, it is formatted instead of the contents of the file,
+// and if onlyPackageFix is set, it is used as is as the file is not being formatted
+func (r *runner) formatFile(overwrite bool, diffMode bool, lintMode bool, fix int, fileHeader string, importFix *formatImportFix, packageFixData []byte, onlyPackageFix bool, meta *meta, protoFile *file.ProtoFile) (bool, error) {
 	input, err := ioutil.ReadFile(protoFile.Path)
 	if err != nil {
 		return false, err
 	}
-	data, failures, err := r.newTransformer(fix, fileHeader).Transform(protoFile.Path, input)
-	if err != nil {
-		return false, err
-	}
-	if len(failures) > 0 {
-		return false, r.printFailures(protoFile.DisplayPath, meta, failures...)
+	data := packageFixData
+	if !onlyPackageFix {
+		transformInput := input
+		if packageFixData != nil {
+			transformInput = packageFixData
+		}
+		var failures []*text.Failure
+		data, failures, err = r.newTransformer(fix, fileHeader, importFix, meta.ProtoSet.Config.Format).Transform(protoFile.Path, transformInput)
+		if err != nil {
+			return false, err
+		}
+		if len(failures) > 0 {
+			return false, r.printFailures(protoFile.DisplayPath, meta, failures...)
+		}
 	}
 	if !bytes.Equal(input, data) {
 		if overwrite {
@@ -448,10 +1134,10 @@ func (r *runner) formatFile(overwrite bool, diffMode bool, lintMode bool, fix in
 		if lintMode {
 			return false, r.printFailures("", meta, text.NewFailuref(scanner.Position{
 				Filename: protoFile.DisplayPath,
-			}, "FORMAT_DIFF", "Format returned a diff."))
+			}, formatDiffID, "Format returned a diff."))
 		}
 		if diffMode {
-			d, err := diff.Do(input, data, protoFile.DisplayPath)
+			d, err := r.diff(input, data, protoFile.DisplayPath)
 			if err != nil {
 				return false, err
 			}
@@ -476,17 +1162,31 @@ func (r *runner) formatFile(overwrite bool, diffMode bool, lintMode bool, fix in
 	return true, nil
 }
 
+func (r *runner) diff(input []byte, output []byte, filename string) ([]byte, error) {
+	doOptions := []diff.DoOption{diff.DoWithContextLines(r.diffContextLines)}
+	if r.diffColor {
+		doOptions = append(doOptions, diff.DoWithColor())
+	}
+	return diff.Do(input, output, filename, doOptions...)
+}
+
 func (r *runner) All(args []string, disableFormat, disableLint, fixFlag bool) error {
 	meta, err := r.getMeta(args)
 	if err != nil {
 		return err
 	}
 	r.printAffectedFiles(meta)
-	if _, err := r.compile(false, false, false, meta); err != nil {
-		return err
-	}
-	if !disableFormat {
-		if err := r.format(true, false, false, getFormatFixValue(fixFlag, meta), getFormatFileHeaderValue(fixFlag, meta), meta); err != nil {
+	if disableFormat {
+		if _, err := r.compile(false, false, false, meta); err != nil {
+			return err
+		}
+	} else {
+		fix := getFormatFixValue(fixFlag, meta)
+		importFixes, err := r.compileForFormat(fix, meta)
+		if err != nil {
+			return err
+		}
+		if err := r.format(true, false, false, fix, getFormatFileHeaderValue(fixFlag, meta), importFixes, nil, meta); err != nil {
 			return err
 		}
 	}
@@ -494,7 +1194,124 @@ func (r *runner) All(args []string, disableFormat, disableLint, fixFlag bool) er
 		return err
 	}
 	if !disableLint {
-		return r.lint(meta)
+		// the files were compiled above, so only compile them again
+		// if the FileDescriptorSets are needed
+		needsFileDescriptorSets, err := r.lintNeedsFileDescriptorSets(meta)
+		if err != nil {
+			return err
+		}
+		var fileDescriptorSets []*descriptor.FileDescriptorSet
+		if needsFileDescriptorSets {
+			fileDescriptorSets, err = r.compileWithCompiler(r.newCompiler(false, true, true), meta)
+			if err != nil {
+				return err
+			}
+		}
+		lintRunner, err := r.newLintRunner(false, fileDescriptorSets)
+		if err != nil {
+			return err
+		}
+		return r.lint(meta, lintRunner)
+	}
+	return nil
+}
+
+func (r *runner) MigrateProto3(args []string, diffMode bool) error {
+	if r.diffContextLines < 0 {
+		return newExitErrorf(255, "diff-context-lines must be non-negative: %d", r.diffContextLines)
+	}
+	meta, err := r.getMeta(args)
+	if err != nil {
+		return err
+	}
+	r.printAffectedFiles(meta)
+	// the files must compile before they are migrated, as the
+	// migration does not check that types and imports are valid,
+	// and the compiled descriptors resolve the types of fields
+	fileDescriptorSets, err := r.compile(false, true, false, meta)
+	if err != nil {
+		return err
+	}
+	absSingleFilename, err := file.AbsClean(meta.SingleFilename)
+	if err != nil {
+		return err
+	}
+	var protoFiles []*file.ProtoFile
+	for dirPath, dirProtoFiles := range meta.ProtoSet.DirPathToFiles {
+		// skip those files not under the directory
+		if !strings.HasPrefix(dirPath, meta.ProtoSet.DirPath) {
+			continue
+		}
+		for _, protoFile := range dirProtoFiles {
+			// we are not concerned with the current file
+			if meta.SingleFilename != "" && protoFile.Path != absSingleFilename {
+				continue
+			}
+			protoFiles = append(protoFiles, protoFile)
+		}
+	}
+	sort.Slice(protoFiles, func(i int, j int) bool {
+		return protoFiles[i].Path < protoFiles[j].Path
+	})
+	migratedFilePaths := make([]string, len(protoFiles))
+	for i, protoFile := range protoFiles {
+		migratedFilePaths[i] = protoFile.Path
+	}
+	migrator := r.newMigrator(meta.ProtoSet.Config.Format, fileDescriptorSets, migratedFilePaths)
+	var allFailures []*text.Failure
+	hasErrors := false
+	hasDiff := false
+	pathToData := make(map[string][]byte)
+	for _, protoFile := range protoFiles {
+		input, err := ioutil.ReadFile(protoFile.Path)
+		if err != nil {
+			return err
+		}
+		data, failures, err := migrator.Proto3(protoFile.DisplayPath, input)
+		if err != nil {
+			return err
+		}
+		for _, failure := range failures {
+			failure.Filename = protoFile.DisplayPath
+		}
+		allFailures = append(allFailures, failures...)
+		// files may use the enums of each other, so no file is written
+		// unless all files can be converted
+		if text.CountFailuresWithSeverityAtLeast(failures, text.SeverityError) > 0 {
+			hasErrors = true
+			continue
+		}
+		if bytes.Equal(input, data) {
+			continue
+		}
+		hasDiff = true
+		if diffMode {
+			d, err := r.diff(input, data, protoFile.DisplayPath)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(r.output, bytes.NewReader(d)); err != nil {
+				return err
+			}
+			continue
+		}
+		pathToData[protoFile.Path] = data
+	}
+	if err := r.printFailures("", meta, allFailures...); err != nil {
+		return err
+	}
+	if !hasErrors && len(pathToData) > 0 {
+		// make sure the migrated files still compile, and restore the
+		// original files if they do not
+		if err := writeFilesOrRestore(pathToData, func() error {
+			_, err := r.compile(false, false, false, meta)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+	if hasErrors || (diffMode && hasDiff) {
+		return newExitErrorf(255, "")
 	}
 	return nil
 }
@@ -593,6 +1410,14 @@ func (r *runner) InspectPackageImporters(args []string, name string) error {
 }
 
 func (r *runner) BreakCheck(args []string, gitBranch string, gitTag string, includeBeta bool, allowBetaDeps bool) error {
+	// the lint config is only used for lint rule metadata, which
+	// break check does not produce
+	return r.withReport(settings.LintConfig{}, func() error {
+		return r.breakCheck(args, gitBranch, gitTag, includeBeta, allowBetaDeps)
+	})
+}
+
+func (r *runner) breakCheck(args []string, gitBranch string, gitTag string, includeBeta bool, allowBetaDeps bool) error {
 	if moreThanOneSet(gitBranch != "", gitTag != "") {
 		return newExitErrorf(255, "can only set one of git-branch, git-tag")
 	}
@@ -759,7 +1584,7 @@ func (r *runner) newDownloader(config settings.Config) (protoc.Downloader, error
 	return protoc.NewDownloader(config, downloaderOptions...)
 }
 
-func (r *runner) newCompiler(doGen bool, doFileDescriptorSet bool) protoc.Compiler {
+func (r *runner) newCompiler(doGen bool, doFileDescriptorSet bool, doSourceInfo bool) protoc.Compiler {
 	compilerOptions := []protoc.CompilerOption{
 		protoc.CompilerWithLogger(r.logger),
 	}
@@ -799,16 +1624,51 @@ func (r *runner) newCompiler(doGen bool, doFileDescriptorSet bool) protoc.Compil
 			protoc.CompilerWithFileDescriptorSet(),
 		)
 	}
+	if doSourceInfo {
+		compilerOptions = append(
+			compilerOptions,
+			protoc.CompilerWithSourceInfo(),
+		)
+	}
 	return protoc.NewCompiler(compilerOptions...)
 }
 
-func (r *runner) newLintRunner() lint.Runner {
-	return lint.NewRunner(
+func (r *runner) newLintRunner(cache bool, fileDescriptorSets []*descriptor.FileDescriptorSet) (lint.Runner, error) {
+	runnerOptions := []lint.RunnerOption{
 		lint.RunnerWithLogger(r.logger),
-	)
+	}
+	if len(fileDescriptorSets) > 0 {
+		runnerOptions = append(
+			runnerOptions,
+			lint.RunnerWithFileDescriptorSets(fileDescriptorSets),
+		)
+	}
+	if cache {
+		cachePath, err := protoc.GetCachePath(r.cachePath)
+		if err != nil {
+			return nil, err
+		}
+		runnerOptions = append(
+			runnerOptions,
+			lint.RunnerWithCachePath(cachePath),
+		)
+	}
+	return lint.NewRunner(runnerOptions...), nil
 }
 
-func (r *runner) newTransformer(fix int, fileHeader string) format.Transformer {
+func (r *runner) newLintFixer(allowBreaking bool) lint.Fixer {
+	fixerOptions := []lint.FixerOption{lint.FixerWithLogger(r.logger)}
+	if allowBreaking {
+		fixerOptions = append(fixerOptions, lint.FixerWithAllowBreaking())
+	}
+	return lint.NewFixer(fixerOptions...)
+}
+
+func (r *runner) newTransformer(fix int, fileHeader string, importFix *formatImportFix, formatConfig settings.FormatConfig) format.Transformer {
+	return format.NewTransformer(r.newTransformerOptions(fix, fileHeader, importFix, formatConfig)...)
+}
+
+func (r *runner) newTransformerOptions(fix int, fileHeader string, importFix *formatImportFix, formatConfig settings.FormatConfig) []format.TransformerOption {
 	transformerOptions := []format.TransformerOption{format.TransformerWithLogger(r.logger)}
 	if fix != format.FixNone {
 		transformerOptions = append(transformerOptions, format.TransformerWithFix(fix))
@@ -816,7 +1676,51 @@ func (r *runner) newTransformer(fix int, fileHeader string) format.Transformer {
 	if fileHeader != "" {
 		transformerOptions = append(transformerOptions, format.TransformerWithFileHeader(fileHeader))
 	}
-	return format.NewTransformer(transformerOptions...)
+	if fix != format.FixNone && importFix != nil {
+		transformerOptions = append(
+			transformerOptions,
+			format.TransformerWithRemoveImports(importFix.removeImports...),
+			format.TransformerWithAddImports(importFix.addImports...),
+		)
+	}
+	if fix != format.FixNone && formatConfig.CanonicalOrder {
+		transformerOptions = append(transformerOptions, format.TransformerWithCanonicalOrder())
+	}
+	if formatConfig.MaxLineLength > 0 {
+		transformerOptions = append(transformerOptions, format.TransformerWithMaxLineLength(formatConfig.MaxLineLength))
+	}
+	if formatConfig.IndentWidth > 0 {
+		transformerOptions = append(transformerOptions, format.TransformerWithIndentWidth(formatConfig.IndentWidth))
+	}
+	if formatConfig.UseTabs {
+		transformerOptions = append(transformerOptions, format.TransformerWithTabs())
+	}
+	if formatConfig.AlignFields {
+		transformerOptions = append(transformerOptions, format.TransformerWithAlignFields())
+	}
+	if formatConfig.AlignEnumValues {
+		transformerOptions = append(transformerOptions, format.TransformerWithAlignEnumValues())
+	}
+	if formatConfig.CommentWidth > 0 {
+		transformerOptions = append(transformerOptions, format.TransformerWithCommentWidth(formatConfig.CommentWidth))
+	}
+	if formatConfig.TopLevelBlankLines > 0 {
+		transformerOptions = append(transformerOptions, format.TransformerWithTopLevelBlankLines(formatConfig.TopLevelBlankLines))
+	}
+	return transformerOptions
+}
+
+func (r *runner) newMover() move.Mover {
+	return move.NewMover(move.MoverWithLogger(r.logger))
+}
+
+func (r *runner) newMigrator(formatConfig settings.FormatConfig, fileDescriptorSets []*descriptor.FileDescriptorSet, migratedFilePaths []string) migrate.Migrator {
+	return migrate.NewMigrator(
+		migrate.MigratorWithLogger(r.logger),
+		migrate.MigratorWithTransformerOptions(r.newTransformerOptions(format.FixNone, "", nil, formatConfig)...),
+		migrate.MigratorWithFileDescriptorSets(fileDescriptorSets),
+		migrate.MigratorWithMigratedFilePaths(migratedFilePaths...),
+	)
 }
 
 func (r *runner) newCreateHandler(pkg string) create.Handler {
@@ -946,7 +1850,9 @@ func (r *runner) printFailuresForErrorFormat(errorFormat string, filename string
 			shouldPrint = true
 		}
 		if shouldPrint {
-			if r.json {
+			if r.reportFailures != nil {
+				*r.reportFailures = append(*r.reportFailures, failure)
+			} else if r.json {
 				data, err := json.Marshal(failure)
 				if err != nil {
 					return err
@@ -962,8 +1868,50 @@ func (r *runner) printFailuresForErrorFormat(errorFormat string, filename string
 	return bufWriter.Flush()
 }
 
+// withReport calls f, and if an output format is set, prints all failures
+// from f in the output format after f returns.
+//
+// The report is printed only if f succeeds or exits due to failures,
+// otherwise the error from f is returned.
+func (r *runner) withReport(lintConfig settings.LintConfig, f func() error) error {
+	if r.outputFormat == "" {
+		return f()
+	}
+	if r.json {
+		return newExitErrorf(255, "can only set one of json, output-format")
+	}
+	format, err := report.ParseFormat(r.outputFormat)
+	if err != nil {
+		return err
+	}
+	reportFailures := make([]*text.Failure, 0)
+	r.reportFailures = &reportFailures
+	defer func() { r.reportFailures = nil }()
+	err = f()
+	// failures result in an ExitError with no message
+	if exitErr, ok := err.(*ExitError); err != nil && (!ok || exitErr.Message != "") {
+		return err
+	}
+	if writeErr := report.Write(r.output, format, vars.Version, getReportRules(lintConfig), reportFailures); writeErr != nil {
+		return writeErr
+	}
+	return err
+}
+
 func (r *runner) printLinters(config settings.LintConfig, linters []lint.Linter) error {
 	sort.Slice(linters, func(i int, j int) bool { return linters[i].ID() < linters[j].ID() })
+	if r.json {
+		for _, linter := range linters {
+			data, err := json.Marshal(newLinterJSON(config, linter))
+			if err != nil {
+				return err
+			}
+			if err := r.println(string(data)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 	tabWriter := newTabWriter(r.output)
 	for _, linter := range linters {
 		if _, err := fmt.Fprintf(tabWriter, "%s\t%s\n", linter.ID(), linter.Purpose(config)); err != nil {
@@ -973,6 +1921,29 @@ func (r *runner) printLinters(config settings.LintConfig, linters []lint.Linter)
 	return tabWriter.Flush()
 }
 
+type linterJSON struct {
+	ID              string `json:"id,omitempty"`
+	Purpose         string `json:"purpose,omitempty"`
+	DefaultSeverity string `json:"default_severity,omitempty"`
+	Rationale       string `json:"rationale,omitempty"`
+	BadExample      string `json:"bad_example,omitempty"`
+	GoodExample     string `json:"good_example,omitempty"`
+}
+
+func newLinterJSON(config settings.LintConfig, linter lint.Linter) *linterJSON {
+	out := &linterJSON{
+		ID:              linter.ID(),
+		Purpose:         linter.Purpose(config),
+		DefaultSeverity: linter.DefaultSeverity(),
+	}
+	if doc := linter.Doc(); doc != nil {
+		out.Rationale = doc.Rationale
+		out.BadExample = strings.TrimSpace(doc.BadExample)
+		out.GoodExample = strings.TrimSpace(doc.GoodExample)
+	}
+	return out
+}
+
 func (r *runner) printAffectedFiles(meta *meta) {
 	for dirPath, files := range meta.ProtoSet.DirPathToFiles {
 		// skip those files not under the directory
@@ -1011,6 +1982,10 @@ func newTabWriter(writer io.Writer) *tabwriter.Writer {
 	return tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
 }
 
+func getFailureKey(failure *text.Failure) string {
+	return fmt.Sprintf("%s:%d:%d:%s", failure.Filename, failure.Line, failure.Column, failure.LintID)
+}
+
 func moreThanOneSet(values ...bool) bool {
 	numSet := 0
 	for _, value := range values {
@@ -1021,11 +1996,62 @@ func moreThanOneSet(values ...bool) bool {
 	return numSet > 1
 }
 
+// parseLineRange parses a line range of the form start:end, where lines
+// start at 1 and both start and end are included.
+//
+// If lines is empty, 0, 0 is returned.
+func parseLineRange(lines string) (int, int, error) {
+	if lines == "" {
+		return 0, 0, nil
+	}
+	split := strings.Split(lines, ":")
+	if len(split) != 2 {
+		return 0, 0, newExitErrorf(255, "lines must be of the form start:end but was %q", lines)
+	}
+	startLine, err := strconv.Atoi(split[0])
+	if err != nil {
+		return 0, 0, newExitErrorf(255, "lines must be of the form start:end but was %q", lines)
+	}
+	endLine, err := strconv.Atoi(split[1])
+	if err != nil {
+		return 0, 0, newExitErrorf(255, "lines must be of the form start:end but was %q", lines)
+	}
+	if startLine < 1 || endLine < startLine {
+		return 0, 0, newExitErrorf(255, "lines must have 1 <= start <= end but was %q", lines)
+	}
+	return startLine, endLine, nil
+}
+
+// formatLineRange returns the input with only the changes from formatting
+// that touch the lines from startLine to endLine of the input applied.
+func formatLineRange(input []byte, data []byte, startLine int, endLine int) []byte {
+	inputLines := strings.Split(string(input), "\n")
+	dataLines := strings.Split(string(data), "\n")
+	var lines []string
+	last := 0
+	for _, edit := range diff.Lines(inputLines, dataLines) {
+		// edits use line indexes starting at 0
+		if edit.OldStart == edit.OldEnd {
+			// insertions directly before or after the range are applied
+			if edit.OldStart < startLine-1 || edit.OldStart > endLine {
+				continue
+			}
+		} else if edit.OldEnd <= startLine-1 || edit.OldStart >= endLine {
+			continue
+		}
+		lines = append(lines, inputLines[last:edit.OldStart]...)
+		lines = append(lines, dataLines[edit.NewStart:edit.NewEnd]...)
+		last = edit.OldEnd
+	}
+	lines = append(lines, inputLines[last:]...)
+	return []byte(strings.Join(lines, "\n"))
+}
+
 func getFormatFixValue(fixFlag bool, meta *meta) int {
 	if !fixFlag {
 		return format.FixNone
 	}
-	if meta.ProtoSet.Config.Lint.Group == "uber2" {
+	if lint.GetBuiltinGroup(meta.ProtoSet.Config.Lint, meta.ProtoSet.Config.Lint.Group) == "uber2" {
 		return format.FixV2
 	}
 	return format.FixV1
@@ -1049,10 +2075,43 @@ func extractSortPackageNames(m map[string]*extract.Package) []string {
 	return s
 }
 
-func getLinterIDs(group string) (map[string]struct{}, error) {
-	linters, ok := lint.GroupToLinters[strings.ToLower(group)]
-	if !ok {
-		return nil, newExitErrorf(255, "unknown lint group: %s", strings.ToLower(group))
+func getReportRules(lintConfig settings.LintConfig) []*report.Rule {
+	rules := []*report.Rule{
+		{
+			ID:          formatDiffID,
+			Description: "Checks that the file is formatted.",
+		},
+	}
+	for _, linter := range lint.AllLinters {
+		rules = append(rules, &report.Rule{
+			ID:          linter.ID(),
+			Description: linter.Purpose(lintConfig),
+		})
+	}
+	checkers := append([]breaking.Checker{breaking.PackagesNoBetaDepsChecker}, breaking.AllCheckers...)
+	for _, checker := range checkers {
+		rules = append(rules, &report.Rule{
+			ID:          checker.ID,
+			Description: checker.Purpose,
+		})
+	}
+	return rules
+}
+
+func getGroupLinters(config settings.LintConfig, group string) ([]lint.Linter, error) {
+	group = strings.ToLower(group)
+	if _, ok := lint.GroupToLinters[group]; !ok {
+		if _, ok := config.Groups[group]; !ok {
+			return nil, newExitErrorf(255, "unknown lint group: %s", group)
+		}
+	}
+	return lint.GetGroupLinters(config, group)
+}
+
+func getLinterIDs(config settings.LintConfig, group string) (map[string]struct{}, error) {
+	linters, err := getGroupLinters(config, group)
+	if err != nil {
+		return nil, err
 	}
 	m := make(map[string]struct{})
 	for _, linter := range linters {
@@ -1076,3 +2135,170 @@ func diffMaps(one map[string]struct{}, two map[string]struct{}) []string {
 	sort.Strings(s)
 	return s
 }
+
+// formatImportFix is the imports to remove from and add to a file in format --fix.
+type formatImportFix struct {
+	removeImports []string
+	addImports    []string
+}
+
+func getFormatImportFix(importFixes map[string]*formatImportFix, path string) *formatImportFix {
+	importFix, ok := importFixes[path]
+	if !ok {
+		importFix = &formatImportFix{}
+		importFixes[path] = importFix
+	}
+	return importFix
+}
+
+func getProtoFileForDisplayPath(protoSet *file.ProtoSet, displayPath string) *file.ProtoFile {
+	if displayPath == "" {
+		return nil
+	}
+	for _, protoFiles := range protoSet.DirPathToFiles {
+		for _, protoFile := range protoFiles {
+			if protoFile.DisplayPath == displayPath {
+				return protoFile
+			}
+		}
+	}
+	return nil
+}
+
+// formatImportResolver resolves the files to import for referenced types
+// that are not imported, using the files in the ProtoSet and the
+// Well-Known Types.
+type formatImportResolver struct {
+	protoSet *file.ProtoSet
+
+	// set by init, as the files are only parsed if needed
+	pathToPackage       map[string]string
+	pathToImport        map[string]string
+	typeNameToFilenames map[string]map[string]struct{}
+}
+
+func newFormatImportResolver(protoSet *file.ProtoSet) *formatImportResolver {
+	return &formatImportResolver{protoSet: protoSet}
+}
+
+// getImportFilename returns the filename to import in the given file to fix
+// the protoc failure with the given message, or empty if the failure is not
+// for a type that is not imported, or the type cannot be resolved to exactly
+// one file.
+func (f *formatImportResolver) getImportFilename(protoFile *file.ProtoFile, message string) (string, error) {
+	if matches := notImportedMessageRegexp.FindStringSubmatch(message); len(matches) > 1 {
+		return matches[1], nil
+	}
+	matches := notDefinedMessageRegexp.FindStringSubmatch(message)
+	if len(matches) < 2 {
+		return "", nil
+	}
+	if err := f.init(); err != nil {
+		return "", err
+	}
+	filenames := make(map[string]struct{})
+	for _, typeName := range getCandidateTypeNames(f.pathToPackage[protoFile.Path], matches[1]) {
+		// nested types are resolved by their top-level type
+		for name := typeName; name != ""; name = getParentName(name) {
+			for filename := range f.typeNameToFilenames[name] {
+				filenames[filename] = struct{}{}
+			}
+		}
+	}
+	// the file cannot fix itself by importing itself
+	delete(filenames, f.pathToImport[protoFile.Path])
+	if len(filenames) != 1 {
+		return "", nil
+	}
+	for filename := range filenames {
+		return filename, nil
+	}
+	return "", nil
+}
+
+func (f *formatImportResolver) init() error {
+	if f.typeNameToFilenames != nil {
+		return nil
+	}
+	f.pathToPackage = make(map[string]string)
+	f.pathToImport = make(map[string]string)
+	f.typeNameToFilenames = make(map[string]map[string]struct{})
+	for typeName, filename := range wkt.TypeNameToFilename {
+		f.addTypeName(typeName, filename)
+	}
+	// imports are relative to the config directory, see the compiler
+	configDirPath := f.protoSet.Config.DirPath
+	if configDirPath == "" {
+		configDirPath = f.protoSet.WorkDirPath
+	}
+	for _, protoFiles := range f.protoSet.DirPathToFiles {
+		for _, protoFile := range protoFiles {
+			relPath, err := filepath.Rel(configDirPath, protoFile.Path)
+			if err != nil {
+				return err
+			}
+			importFilename := filepath.ToSlash(relPath)
+			f.pathToImport[protoFile.Path] = importFilename
+			data, err := ioutil.ReadFile(protoFile.Path)
+			if err != nil {
+				return err
+			}
+			definition, err := proto.NewParser(bytes.NewReader(data)).Parse()
+			if err != nil {
+				// this will be reported when formatting the file
+				continue
+			}
+			pkg := ""
+			var names []string
+			for _, element := range definition.Elements {
+				switch t := element.(type) {
+				case *proto.Package:
+					pkg = t.Name
+				case *proto.Message:
+					names = append(names, t.Name)
+				case *proto.Enum:
+					names = append(names, t.Name)
+				}
+			}
+			f.pathToPackage[protoFile.Path] = pkg
+			for _, name := range names {
+				if pkg != "" {
+					name = pkg + "." + name
+				}
+				f.addTypeName(name, importFilename)
+			}
+		}
+	}
+	return nil
+}
+
+func (f *formatImportResolver) addTypeName(typeName string, filename string) {
+	filenames, ok := f.typeNameToFilenames[typeName]
+	if !ok {
+		filenames = make(map[string]struct{})
+		f.typeNameToFilenames[typeName] = filenames
+	}
+	filenames[filename] = struct{}{}
+}
+
+// getCandidateTypeNames returns the fully-qualified names that the given type
+// reference can resolve to in the given package, per the scoping rules of protoc.
+func getCandidateTypeNames(pkg string, typeName string) []string {
+	if strings.HasPrefix(typeName, ".") {
+		return []string{strings.TrimPrefix(typeName, ".")}
+	}
+	var typeNames []string
+	for scope := pkg; scope != ""; scope = getParentName(scope) {
+		typeNames = append(typeNames, scope+"."+typeName)
+	}
+	return append(typeNames, typeName)
+}
+
+// getParentName returns the name without the last dot-separated
+// component, or empty if there is only one component.
+func getParentName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[:i]
+	}
+	return ""
+}
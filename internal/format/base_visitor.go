@@ -25,6 +25,7 @@ import (
 	"sort"
 	"strings"
 	"text/scanner"
+	"unicode/utf8"
 
 	"github.com/emicklei/proto"
 	"github.com/uber/prototool/internal/text"
@@ -34,10 +35,31 @@ type baseVisitor struct {
 	*printer
 
 	Failures []*text.Failure
+
+	// alignment is the alignment of the fields currently being printed
+	alignment fieldAlignment
+}
+
+// fieldAlignment holds the widths of the columns that consecutive fields
+// are aligned to, which are 0 if the fields are not aligned.
+type fieldAlignment struct {
+	typeWidth int
+	nameWidth int
+}
+
+// update widens the columns to fit the given field type, including any
+// label, and the given field name.
+func (a *fieldAlignment) update(fieldType string, fieldName string) {
+	if typeWidth := utf8.RuneCountInString(fieldType); typeWidth > a.typeWidth {
+		a.typeWidth = typeWidth
+	}
+	if nameWidth := utf8.RuneCountInString(fieldName); nameWidth > a.nameWidth {
+		a.nameWidth = nameWidth
+	}
 }
 
-func newBaseVisitor() *baseVisitor {
-	return &baseVisitor{printer: newPrinter()}
+func newBaseVisitor(options printOptions) *baseVisitor {
+	return &baseVisitor{printer: newPrinter(options)}
 }
 
 func (v *baseVisitor) AddFailure(position scanner.Position, format string, args ...interface{}) {
@@ -49,28 +71,50 @@ func (v *baseVisitor) AddFailure(position scanner.Position, format string, args
 }
 
 func (v *baseVisitor) PWithInlineComment(inlineComment *proto.Comment, args ...interface{}) {
-	if inlineComment == nil || len(inlineComment.Lines) == 0 {
+	lines := v.getCommentLines(inlineComment)
+	if len(lines) == 0 {
 		v.P(args...)
 		return
 	}
 	// https://github.com/emicklei/proto/commit/5a91db7561a4dedab311f36304fcf0512343a9b1
-	args = append(args, ` //`, cleanCommentLine(inlineComment.Lines[0]))
+	args = append(args, ` //`, lines[0])
 	v.P(args...)
-	for _, line := range inlineComment.Lines[1:] {
-		v.P(`//`, cleanCommentLine(line))
+	for _, line := range lines[1:] {
+		v.P(`//`, line)
 	}
 }
 
 func (v *baseVisitor) PComment(comment *proto.Comment) {
-	if comment == nil || len(comment.Lines) == 0 {
+	lines := v.getCommentLines(comment)
+	if len(lines) == 0 {
 		return
 	}
+	// /* */ comments are only reflowed once their decorations are removed
+	if v.commentWidth > 0 && (!comment.Cstyle || v.fixCStyleComments) {
+		// the text of each line starts after the indent and "// "
+		lines = reflowCommentLines(lines, v.commentWidth-v.indentCount*v.indentWidth-3)
+	}
+	for _, line := range lines {
+		v.P(`//`, line)
+	}
+}
+
+// getCommentLines returns the lines of the comment as the text after //.
+func (v *baseVisitor) getCommentLines(comment *proto.Comment) []string {
+	if comment == nil || len(comment.Lines) == 0 {
+		return nil
+	}
 	// https://github.com/emicklei/proto/commit/5a91db7561a4dedab311f36304fcf0512343a9b1
 	// this is weird for now
 	// we always want non-c-style after formatting
-	for _, line := range comment.Lines {
-		v.P(`//`, cleanCommentLine(line))
+	if comment.Cstyle && v.fixCStyleComments {
+		return cleanCStyleCommentLines(comment.Lines)
+	}
+	lines := make([]string, len(comment.Lines))
+	for i, line := range comment.Lines {
+		lines[i] = cleanCommentLine(line)
 	}
+	return lines
 }
 
 func (v *baseVisitor) POptions(options ...*proto.Option) {
@@ -80,8 +124,10 @@ func (v *baseVisitor) POptions(options ...*proto.Option) {
 // fieldType can be "" in case of enum field
 func (v *baseVisitor) pMessageOrEnumField(prefix string, fieldName string, fieldType string, fieldTag int, comment *proto.Comment, inlineComment *proto.Comment, options ...*proto.Option) {
 	if fieldType != "" {
-		fieldType = fieldType + " "
+		fieldType = padRight(prefix+fieldType, v.alignment.typeWidth) + " "
+		prefix = ""
 	}
+	fieldName = padRight(fieldName, v.alignment.nameWidth)
 	v.PComment(comment)
 	if len(options) == 0 {
 		v.PWithInlineComment(inlineComment, prefix, fieldType, fieldName, " = ", fieldTag, ";")
@@ -91,8 +137,13 @@ func (v *baseVisitor) pMessageOrEnumField(prefix string, fieldName string, field
 		o := options[0]
 		if isSingleValueLiteral(o.Constant) {
 			if source := o.Constant.SourceRepresentation(); source != "" {
-				v.PWithInlineComment(inlineComment, prefix, fieldType, fieldName, " = ", fieldTag, " [", o.Name, ` = `, source, "];")
-				return
+				// if the option does not fit on the same line, it is
+				// printed on its own line like multiple options are
+				args := []interface{}{prefix, fieldType, fieldName, " = ", fieldTag, " [", o.Name, ` = `, source, "];"}
+				if v.Fits(args...) {
+					v.PWithInlineComment(inlineComment, args...)
+					return
+				}
 			}
 		}
 	}
@@ -197,6 +248,14 @@ func (v *baseVisitor) PEnumField(element *proto.EnumField) {
 	v.pMessageOrEnumField("", element.Name, "", element.Integer, element.Comment, element.InlineComment, element.ValueOption)
 }
 
+// padRight pads s with spaces to the given width in characters.
+func padRight(s string, width int) string {
+	if length := utf8.RuneCountInString(s); length < width {
+		return s + strings.Repeat(" ", width-length)
+	}
+	return s
+}
+
 func cleanCommentLine(line string) string {
 	// TODO: this is not great
 	return strings.TrimLeft(line, "/")
@@ -0,0 +1,153 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package format
+
+import (
+	"sort"
+
+	"github.com/emicklei/proto"
+)
+
+// canonicalUnit is a top-level declaration together with the
+// standalone comments directly before it, which move with it.
+type canonicalUnit struct {
+	elements []proto.Visitee
+	// declaration is the message, enum or service of the unit,
+	// or nil if the unit only holds standalone comments.
+	declaration proto.Visitee
+}
+
+// canonicalOrder returns the top-level elements in canonical order.
+//
+// Leading comments, syntax, package, file options and imports come first
+// in their original order. Then come all services, then the request and
+// response types of each RPC in the order of the RPCs, and then all other
+// messages, enums and extensions in their original order. Options in the
+// bodies of messages, enums, oneofs and services are moved to the start
+// of the body and sorted by name.
+//
+// This matches what REQUEST_RESPONSE_TYPES_AFTER_SERVICE expects.
+func canonicalOrder(elements []proto.Visitee) []proto.Visitee {
+	var header []proto.Visitee
+	var units []*canonicalUnit
+	var pending []proto.Visitee
+	haveHitNonComment := false
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.Comment:
+			if !haveHitNonComment {
+				// leading comments are printed by the first pass visitor
+				header = append(header, e)
+				continue
+			}
+			pending = append(pending, e)
+		case *proto.Syntax, *proto.Package, *proto.Option, *proto.Import:
+			haveHitNonComment = true
+			header = append(header, pending...)
+			header = append(header, e)
+			pending = nil
+		default:
+			haveHitNonComment = true
+			sortBodyOptions(e)
+			units = append(units, &canonicalUnit{
+				elements:    append(pending, e),
+				declaration: e,
+			})
+			pending = nil
+		}
+	}
+
+	messageNameToUnit := make(map[string]*canonicalUnit)
+	var services []*proto.Service
+	for _, unit := range units {
+		switch e := unit.declaration.(type) {
+		case *proto.Message:
+			if !e.IsExtend {
+				messageNameToUnit[e.Name] = unit
+			}
+		case *proto.Service:
+			services = append(services, e)
+		}
+	}
+
+	placed := make(map[*canonicalUnit]bool)
+	sorted := make([]proto.Visitee, 0, len(elements))
+	sorted = append(sorted, header...)
+	place := func(unit *canonicalUnit) {
+		if unit == nil || placed[unit] {
+			return
+		}
+		placed[unit] = true
+		sorted = append(sorted, unit.elements...)
+	}
+	for _, unit := range units {
+		if _, ok := unit.declaration.(*proto.Service); ok {
+			place(unit)
+		}
+	}
+	for _, service := range services {
+		for _, child := range service.Elements {
+			if rpc, ok := child.(*proto.RPC); ok {
+				place(messageNameToUnit[rpc.RequestType])
+				place(messageNameToUnit[rpc.ReturnsType])
+			}
+		}
+	}
+	for _, unit := range units {
+		place(unit)
+	}
+	// trailing comments stay at the end of the file
+	return append(sorted, pending...)
+}
+
+// sortBodyOptions moves the options in the body of the element and
+// any nested elements to the start of the body and sorts them by name.
+func sortBodyOptions(element proto.Visitee) {
+	switch e := element.(type) {
+	case *proto.Message:
+		e.Elements = sortOptionsFirst(e.Elements)
+	case *proto.Enum:
+		e.Elements = sortOptionsFirst(e.Elements)
+	case *proto.Oneof:
+		e.Elements = sortOptionsFirst(e.Elements)
+	case *proto.Service:
+		e.Elements = sortOptionsFirst(e.Elements)
+	case *proto.Group:
+		e.Elements = sortOptionsFirst(e.Elements)
+	}
+}
+
+func sortOptionsFirst(elements []proto.Visitee) []proto.Visitee {
+	var options []proto.Visitee
+	var others []proto.Visitee
+	for _, element := range elements {
+		if _, ok := element.(*proto.Option); ok {
+			options = append(options, element)
+			continue
+		}
+		sortBodyOptions(element)
+		others = append(others, element)
+	}
+	sort.SliceStable(options, func(i int, j int) bool {
+		return options[i].(*proto.Option).Name < options[j].(*proto.Option).Name
+	})
+	return append(options, others...)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package format
+
+import (
+	"regexp"
+	"strings"
+
+	wordwrap "github.com/mitchellh/go-wordwrap"
+)
+
+// minCommentTextWidth is the minimum width of the text of a reflowed
+// comment line, so that deeply indented comments are not wrapped after
+// every word.
+const minCommentTextWidth = 40
+
+var listItemRegexp = regexp.MustCompile(`^([-*+]|[0-9]+[.)])\s+`)
+
+// cleanCStyleCommentLines returns the lines of a /* */ comment as the
+// lines of an equivalent // comment.
+//
+// Leading " * " decorations, trailing whitespace, and blank lines at the
+// start and end of the comment are removed.
+func cleanCStyleCommentLines(lines []string) []string {
+	if len(lines) == 0 {
+		return nil
+	}
+	// only strip decorations if every line after the first has one
+	decorated := true
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && trimmed != "*" && !strings.HasPrefix(trimmed, "* ") {
+			decorated = false
+		}
+	}
+	cleaned := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t")
+		trimmed := strings.TrimLeft(line, " \t")
+		switch {
+		case trimmed == "" || trimmed == "*":
+			line = ""
+		case decorated && strings.HasPrefix(trimmed, "* "):
+			line = trimmed[1:]
+		case !strings.HasPrefix(line, " "):
+			line = " " + line
+		}
+		cleaned = append(cleaned, line)
+	}
+	for len(cleaned) > 0 && cleaned[0] == "" {
+		cleaned = cleaned[1:]
+	}
+	for len(cleaned) > 0 && cleaned[len(cleaned)-1] == "" {
+		cleaned = cleaned[:len(cleaned)-1]
+	}
+	return cleaned
+}
+
+// reflowCommentLines wraps the paragraphs of the given comment lines,
+// which are the text after //, so that the text after "// " is at most
+// width characters.
+//
+// Paragraphs are separated by blank lines. List items start a new
+// paragraph and are wrapped with a hanging indent. Lines indented by more
+// than one space and lines in ``` fenced blocks are code and are left as is.
+func reflowCommentLines(lines []string, width int) []string {
+	if width < minCommentTextWidth {
+		width = minCommentTextWidth
+	}
+	var reflowed []string
+	var paragraph []string
+	var prefix string
+	var hangingIndent string
+	flush := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		wrapped := wordwrap.WrapString(strings.Join(paragraph, " "), uint(width-len(prefix)))
+		for i, line := range strings.Split(wrapped, "\n") {
+			if i == 0 {
+				reflowed = append(reflowed, " "+prefix+line)
+			} else {
+				reflowed = append(reflowed, " "+hangingIndent+line)
+			}
+		}
+		paragraph = nil
+		prefix = ""
+		hangingIndent = ""
+	}
+	inFence := false
+	for _, line := range lines {
+		content := strings.TrimPrefix(line, " ")
+		trimmed := strings.TrimSpace(content)
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flush()
+			inFence = !inFence
+			reflowed = append(reflowed, line)
+		case inFence:
+			reflowed = append(reflowed, line)
+		case trimmed == "":
+			flush()
+			reflowed = append(reflowed, "")
+		case listItemRegexp.MatchString(content):
+			flush()
+			marker := listItemRegexp.FindString(content)
+			prefix = strings.TrimSpace(marker) + " "
+			hangingIndent = strings.Repeat(" ", len(prefix))
+			paragraph = append(paragraph, strings.Fields(content[len(marker):])...)
+		case hangingIndent != "" && strings.HasPrefix(content, hangingIndent) && len(paragraph) > 0:
+			// continuation of a list item
+			paragraph = append(paragraph, strings.Fields(content)...)
+		case strings.HasPrefix(content, " ") || strings.HasPrefix(content, "\t") || line == content:
+			// code, or text directly after // which we do not want to move
+			flush()
+			reflowed = append(reflowed, line)
+		default:
+			if hangingIndent != "" {
+				flush()
+			}
+			paragraph = append(paragraph, strings.Fields(content)...)
+		}
+	}
+	flush()
+	return reflowed
+}
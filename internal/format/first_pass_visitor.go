@@ -50,10 +50,12 @@ type firstPassVisitor struct {
 	csharpNamespaceOption    *proto.Option
 	objcClassPrefixOption    *proto.Option
 	phpNamespaceOption       *proto.Option
+	removeImports            map[string]struct{}
+	addImports               []string
 }
 
-func newFirstPassVisitor(filename string, fix int, fileHeader string) *firstPassVisitor {
-	return &firstPassVisitor{baseVisitor: newBaseVisitor(), filename: filename, fix: fix, fileHeader: fileHeader}
+func newFirstPassVisitor(filename string, fix int, fileHeader string, options printOptions) *firstPassVisitor {
+	return &firstPassVisitor{baseVisitor: newBaseVisitor(options), filename: filename, fix: fix, fileHeader: fileHeader}
 }
 
 func (v *firstPassVisitor) Do() []*text.Failure {
@@ -69,12 +71,12 @@ func (v *firstPassVisitor) Do() []*text.Failure {
 			v.P()
 		}
 		v.PWithInlineComment(v.Syntax.InlineComment, `syntax = "`, v.Syntax.Value, `";`)
-		v.P()
+		v.PBlankLines()
 	}
 	if v.Package != nil {
 		v.PComment(v.Package.Comment)
 		v.PWithInlineComment(v.Package.InlineComment, `package `, v.Package.Name, `;`)
-		v.P()
+		v.PBlankLines()
 	}
 	if v.fix != FixNone && v.Package != nil {
 		if v.goPackageOption == nil {
@@ -154,11 +156,14 @@ func (v *firstPassVisitor) Do() []*text.Failure {
 	}
 	if len(v.Options) > 0 {
 		v.POptions(v.Options...)
-		v.P()
+		v.PBlankLines()
+	}
+	if v.fix != FixNone {
+		v.Imports = appendMissingImports(v.Imports, v.addImports)
 	}
 	if len(v.Imports) > 0 {
 		v.PImports(v.Imports)
-		v.P()
+		v.PBlankLines()
 	}
 	return v.Failures
 }
@@ -223,6 +228,11 @@ func (v *firstPassVisitor) VisitOption(element *proto.Option) {
 
 func (v *firstPassVisitor) VisitImport(element *proto.Import) {
 	v.haveHitNonComment = true
+	if v.fix != FixNone {
+		if _, ok := v.removeImports[element.Filename]; ok {
+			return
+		}
+	}
 	v.Imports = append(v.Imports, element)
 }
 
@@ -295,3 +305,21 @@ func (v *firstPassVisitor) PImports(imports []*proto.Import) {
 		v.PWithInlineComment(i.InlineComment, `import `, kind, `"`, i.Filename, `";`)
 	}
 }
+
+// appendMissingImports appends an import for each of the given filenames
+// that is not already imported.
+func appendMissingImports(imports []*proto.Import, filenames []string) []*proto.Import {
+	for _, filename := range filenames {
+		found := false
+		for _, i := range imports {
+			if i.Filename == filename {
+				found = true
+				break
+			}
+		}
+		if !found {
+			imports = append(imports, &proto.Import{Filename: filename})
+		}
+	}
+	return imports
+}
@@ -21,6 +21,9 @@
 package format
 
 import (
+	"strings"
+
+	"github.com/emicklei/proto"
 	"github.com/uber/prototool/internal/text"
 	"go.uber.org/zap"
 )
@@ -38,9 +41,11 @@ const (
 type Transformer interface {
 	// Transform transforms the data.
 	//
-	// Failures should never happen in the CLI tool as we run the files
-	// through protoc first, but this is done because we want to verify
-	// code correctness here and protect against the bad case.
+	// Syntax errors are returned as failures with the line and column of
+	// the error, as the files are not always compiled with protoc first.
+	// Other failures should never happen in the CLI tool as the files are
+	// otherwise valid, but this is done because we want to verify code
+	// correctness here and protect against the bad case.
 	Transform(filename string, data []byte) ([]byte, []*text.Failure, error)
 }
 
@@ -74,6 +79,129 @@ func TransformerWithFileHeader(fileHeader string) TransformerOption {
 	}
 }
 
+// TransformerWithCanonicalOrder returns a TransformerOption that will
+// order the top-level elements of the file so that services come first,
+// followed by the request and response types of each RPC in the order of
+// the RPCs, and then all other messages and enums. Options in the bodies
+// of messages, enums and services are moved to the start of the body and
+// sorted by name.
+//
+// This is only valid if fix is set to a value other than FixNone.
+func TransformerWithCanonicalOrder() TransformerOption {
+	return func(transformer *transformer) {
+		transformer.canonicalOrder = true
+	}
+}
+
+// TransformerWithRemoveImports returns a TransformerOption that will
+// remove the imports of the given filenames, such as the imports that
+// protoc reports as unused.
+//
+// This is only valid if fix is set to a value other than FixNone.
+func TransformerWithRemoveImports(filenames ...string) TransformerOption {
+	return func(transformer *transformer) {
+		if transformer.removeImports == nil {
+			transformer.removeImports = make(map[string]struct{})
+		}
+		for _, filename := range filenames {
+			transformer.removeImports[filename] = struct{}{}
+		}
+	}
+}
+
+// TransformerWithAddImports returns a TransformerOption that will add
+// imports of the given filenames if they are not already imported, such
+// as the files that define types that are referenced but not imported.
+//
+// This is only valid if fix is set to a value other than FixNone.
+func TransformerWithAddImports(filenames ...string) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.addImports = append(transformer.addImports, filenames...)
+	}
+}
+
+// TransformerWithModifier returns a TransformerOption that calls the given
+// function with the parsed file before it is printed, so that the file can
+// be changed, and adds the failures it returns to the failures of Transform.
+func TransformerWithModifier(modifier func(*proto.Proto) []*text.Failure) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.modifier = modifier
+	}
+}
+
+// TransformerWithMaxLineLength returns a TransformerOption that breaks
+// compact field options and RPC signatures across lines if they are longer
+// than the given number of characters.
+//
+// Comments are not counted, and lines that cannot be broken are left as is.
+// The default is to not have a maximum line length.
+func TransformerWithMaxLineLength(maxLineLength int) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.maxLineLength = maxLineLength
+	}
+}
+
+// TransformerWithIndentWidth returns a TransformerOption that indents
+// with the given number of spaces per level.
+//
+// If TransformerWithTabs is also given, this is the width of a tab when
+// counting line lengths. The default is two spaces.
+func TransformerWithIndentWidth(indentWidth int) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.indentWidth = indentWidth
+		if transformer.printOptions.indent != "\t" {
+			transformer.printOptions.indent = strings.Repeat(" ", indentWidth)
+		}
+	}
+}
+
+// TransformerWithTabs returns a TransformerOption that indents with
+// one tab per level instead of spaces.
+func TransformerWithTabs() TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.indent = "\t"
+	}
+}
+
+// TransformerWithAlignFields returns a TransformerOption that aligns the
+// names and numbers of consecutive message fields in columns.
+func TransformerWithAlignFields() TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.alignFields = true
+	}
+}
+
+// TransformerWithAlignEnumValues returns a TransformerOption that aligns
+// the numbers of consecutive enum values in a column.
+func TransformerWithAlignEnumValues() TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.alignEnumValues = true
+	}
+}
+
+// TransformerWithTopLevelBlankLines returns a TransformerOption that
+// prints the given number of blank lines between top-level elements.
+//
+// The default is one blank line.
+func TransformerWithTopLevelBlankLines(topLevelBlankLines int) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.topLevelBlankLines = topLevelBlankLines
+	}
+}
+
+// TransformerWithCommentWidth returns a TransformerOption that reflows
+// the paragraphs of // comments so that lines are at most the given number
+// of characters.
+//
+// Blank lines, list items, code indented by more than one space, and
+// ``` fenced blocks are preserved. Inline comments are not reflowed.
+// The default is to not reflow comments.
+func TransformerWithCommentWidth(commentWidth int) TransformerOption {
+	return func(transformer *transformer) {
+		transformer.printOptions.commentWidth = commentWidth
+	}
+}
+
 // NewTransformer returns a new Transformer.
 func NewTransformer(options ...TransformerOption) Transformer {
 	return newTransformer(options...)
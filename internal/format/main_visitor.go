@@ -38,8 +38,8 @@ type mainVisitor struct {
 	parent            proto.Visitee
 }
 
-func newMainVisitor(isProto2 bool) *mainVisitor {
-	return &mainVisitor{isProto2: isProto2, baseVisitor: newBaseVisitor()}
+func newMainVisitor(isProto2 bool, options printOptions) *mainVisitor {
+	return &mainVisitor{isProto2: isProto2, baseVisitor: newBaseVisitor(options)}
 }
 
 func (v *mainVisitor) Do() []*text.Failure {
@@ -55,21 +55,23 @@ func (v *mainVisitor) VisitMessage(element *proto.Message) {
 	}
 	if len(element.Elements) == 0 {
 		v.P(prefix, element.Name, " {}")
-		v.P()
+		if v.parent == nil {
+			v.PBlankLines()
+		} else {
+			v.P()
+		}
 		return
 	}
 	v.P(prefix, element.Name, " {")
 	v.In()
 	originalParent := v.parent
 	v.parent = element
-	for _, child := range element.Elements {
-		child.Accept(v)
-	}
+	v.visitChildren(element.Elements)
 	v.parent = originalParent
 	v.Out()
 	v.P("}")
 	if v.parent == nil {
-		v.P()
+		v.PBlankLines()
 	}
 }
 
@@ -78,20 +80,18 @@ func (v *mainVisitor) VisitService(element *proto.Service) {
 	v.PComment(element.Comment)
 	if len(element.Elements) == 0 {
 		v.P("service ", element.Name, " {}")
-		v.P()
+		v.PBlankLines()
 		return
 	}
 	v.P("service ", element.Name, " {")
 	v.In()
 	originalParent := v.parent
 	v.parent = element
-	for _, child := range element.Elements {
-		child.Accept(v)
-	}
+	v.visitChildren(element.Elements)
 	v.parent = originalParent
 	v.Out()
 	v.P("}")
-	v.P()
+	v.PBlankLines()
 }
 
 func (v *mainVisitor) VisitSyntax(element *proto.Syntax) {
@@ -131,6 +131,10 @@ func (v *mainVisitor) VisitImport(element *proto.Import) {
 
 func (v *mainVisitor) VisitNormalField(element *proto.NormalField) {
 	v.haveHitNonComment = true
+	v.PField(v.getNormalFieldPrefix(element), element.Type, element.Field)
+}
+
+func (v *mainVisitor) getNormalFieldPrefix(element *proto.NormalField) string {
 	prefix := ""
 	if v.isProto2 {
 		// technically these are only set if the file is proto2
@@ -144,7 +148,63 @@ func (v *mainVisitor) VisitNormalField(element *proto.NormalField) {
 	if element.Repeated {
 		prefix = "repeated "
 	}
-	v.PField(prefix, element.Type, element.Field)
+	return prefix
+}
+
+// visitChildren visits the child elements of a message, enum, oneof,
+// service or group, aligning consecutive fields in columns if configured.
+func (v *mainVisitor) visitChildren(elements []proto.Visitee) {
+	originalAlignment := v.alignment
+	for i := 0; i < len(elements); {
+		j := i
+		alignment := fieldAlignment{}
+		for ; j < len(elements); j++ {
+			fieldType, fieldName, ok := v.getAlignedFieldDeclaration(elements[j])
+			if !ok {
+				break
+			}
+			alignment.update(fieldType, fieldName)
+		}
+		v.alignment = alignment
+		for ; i < j; i++ {
+			elements[i].Accept(v)
+		}
+		v.alignment = fieldAlignment{}
+		if i < len(elements) {
+			elements[i].Accept(v)
+			i++
+		}
+	}
+	v.alignment = originalAlignment
+}
+
+// getAlignedFieldDeclaration returns the type, including any label, and
+// the name of the element if it is a field that should be aligned.
+func (v *mainVisitor) getAlignedFieldDeclaration(element proto.Visitee) (string, string, bool) {
+	switch e := element.(type) {
+	case *proto.NormalField:
+		if !v.alignFields {
+			return "", "", false
+		}
+		return v.getNormalFieldPrefix(e) + e.Type, e.Name, true
+	case *proto.MapField:
+		if !v.alignFields {
+			return "", "", false
+		}
+		return getMapFieldType(e), e.Name, true
+	case *proto.OneOfField:
+		if !v.alignFields {
+			return "", "", false
+		}
+		return e.Type, e.Name, true
+	case *proto.EnumField:
+		if !v.alignEnumValues {
+			return "", "", false
+		}
+		return "", e.Name, true
+	default:
+		return "", "", false
+	}
 }
 
 func (v *mainVisitor) VisitEnumField(element *proto.EnumField) {
@@ -157,28 +217,34 @@ func (v *mainVisitor) VisitEnum(element *proto.Enum) {
 	v.PComment(element.Comment)
 	if len(element.Elements) == 0 {
 		v.P("enum ", element.Name, " {}")
-		v.P()
+		if v.parent == nil {
+			v.PBlankLines()
+		} else {
+			v.P()
+		}
 		return
 	}
 	v.P("enum ", element.Name, " {")
 	v.In()
 	originalParent := v.parent
 	v.parent = element
-	for _, child := range element.Elements {
-		child.Accept(v)
-	}
+	v.visitChildren(element.Elements)
 	v.parent = originalParent
 	v.Out()
 	v.P("}")
 	if v.parent == nil {
-		v.P()
+		v.PBlankLines()
 	}
 }
 
 func (v *mainVisitor) VisitComment(element *proto.Comment) {
 	if v.haveHitNonComment {
 		v.PComment(element)
-		v.P()
+		if v.parent == nil {
+			v.PBlankLines()
+		} else {
+			v.P()
+		}
 	}
 }
 
@@ -194,9 +260,7 @@ func (v *mainVisitor) VisitOneof(element *proto.Oneof) {
 	v.In()
 	originalParent := v.parent
 	v.parent = element
-	for _, child := range element.Elements {
-		child.Accept(v)
-	}
+	v.visitChildren(element.Elements)
 	v.parent = originalParent
 	v.Out()
 	v.P("}")
@@ -242,20 +306,47 @@ func (v *mainVisitor) VisitRPC(element *proto.RPC) {
 	if element.StreamsReturns {
 		responseStream = "stream "
 	}
+	suffix := ";"
+	if len(element.Options) > 0 {
+		suffix = " {"
+	}
+	signature := []interface{}{"rpc ", element.Name, "(", requestStream, element.RequestType, ")"}
+	returns := []interface{}{"returns (", responseStream, element.ReturnsType, ")", suffix}
 	if len(element.Options) == 0 {
-		v.PWithInlineComment(element.InlineComment, "rpc ", element.Name, "(", requestStream, element.RequestType, ") returns (", responseStream, element.ReturnsType, ");")
+		v.pRPCSignature(element.InlineComment, signature, returns)
 		return
 	}
-	v.P("rpc ", element.Name, "(", requestStream, element.RequestType, ") returns (", responseStream, element.ReturnsType, ") {")
+	v.pRPCSignature(nil, signature, returns)
 	v.In()
 	v.POptions(element.Options...)
 	v.Out()
 	v.PWithInlineComment(element.InlineComment, "}")
 }
 
+// pRPCSignature prints the signature of an RPC on one line, or if it does
+// not fit, with the returns clause on a line with two extra indents so
+// that it stands out from the options of the RPC.
+func (v *mainVisitor) pRPCSignature(inlineComment *proto.Comment, signature []interface{}, returns []interface{}) {
+	line := append(append(append([]interface{}{}, signature...), " "), returns...)
+	if v.Fits(line...) {
+		v.PWithInlineComment(inlineComment, line...)
+		return
+	}
+	v.P(signature...)
+	v.In()
+	v.In()
+	v.PWithInlineComment(inlineComment, returns...)
+	v.Out()
+	v.Out()
+}
+
 func (v *mainVisitor) VisitMapField(element *proto.MapField) {
 	v.haveHitNonComment = true
-	v.PField("", fmt.Sprintf("map<%s, %s>", element.KeyType, element.Type), element.Field)
+	v.PField("", getMapFieldType(element), element.Field)
+}
+
+func getMapFieldType(element *proto.MapField) string {
+	return fmt.Sprintf("map<%s, %s>", element.KeyType, element.Type)
 }
 
 func (v *mainVisitor) VisitGroup(element *proto.Group) {
@@ -274,9 +365,7 @@ func (v *mainVisitor) VisitGroup(element *proto.Group) {
 	v.In()
 	originalParent := v.parent
 	v.parent = element
-	for _, child := range element.Elements {
-		child.Accept(v)
-	}
+	v.visitChildren(element.Elements)
 	v.parent = originalParent
 	v.Out()
 	v.P("}")
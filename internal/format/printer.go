@@ -24,19 +24,53 @@ import (
 	"bytes"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 )
 
-const indentString = "  "
+// printOptions are the options that control how proto files are printed.
+type printOptions struct {
+	// indent is printed once per indent level.
+	indent string
+	// indentWidth is the width of indent when counting line lengths.
+	indentWidth int
+	// maxLineLength is the maximum line length, or 0 for no maximum.
+	maxLineLength int
+	// alignFields says to align the names and numbers of consecutive
+	// message fields in columns.
+	alignFields bool
+	// alignEnumValues says to align the numbers of consecutive enum
+	// values in a column.
+	alignEnumValues bool
+	// topLevelBlankLines is the number of blank lines between
+	// top-level elements.
+	topLevelBlankLines int
+	// commentWidth is the maximum line length that comments are
+	// reflowed to, or 0 to not reflow comments.
+	commentWidth int
+	// fixCStyleComments says to remove the decorations of /* */
+	// comments when printing them as // comments.
+	fixCStyleComments bool
+}
+
+func newPrintOptions() printOptions {
+	return printOptions{
+		indent:             "  ",
+		indentWidth:        2,
+		topLevelBlankLines: 1,
+	}
+}
 
 // printer is a convenience struct that helps when printing proto files.
 // The concept was taken from the golang/protobuf plugin.
 type printer struct {
+	printOptions
+
 	buffer      *bytes.Buffer
 	indentCount int
 }
 
-func newPrinter() *printer {
-	return &printer{bytes.NewBuffer(nil), 0}
+func newPrinter(options printOptions) *printer {
+	return &printer{printOptions: options, buffer: bytes.NewBuffer(nil)}
 }
 
 // P prints the args concatenated on the same line after printing the current indent and then prints a newline.
@@ -45,7 +79,7 @@ func newPrinter() *printer {
 func (p *printer) P(args ...interface{}) {
 	lineBuffer := bytes.NewBuffer(nil)
 	if p.indentCount > 0 {
-		_, _ = fmt.Fprint(lineBuffer, strings.Repeat(indentString, p.indentCount))
+		_, _ = fmt.Fprint(lineBuffer, strings.Repeat(p.indent, p.indentCount))
 	}
 	for _, arg := range args {
 		_, _ = fmt.Fprint(lineBuffer, arg)
@@ -58,11 +92,34 @@ func (p *printer) P(args ...interface{}) {
 	_, _ = p.buffer.WriteRune('\n')
 }
 
+// Fits returns true if the args would fit within the maximum line length
+// if printed on the same line with P, or if there is no maximum.
+//
+// Lengths are counted in characters, where the indent counts as
+// indentWidth characters per level.
+func (p *printer) Fits(args ...interface{}) bool {
+	if p.maxLineLength <= 0 {
+		return true
+	}
+	length := p.indentCount * p.indentWidth
+	for _, arg := range args {
+		length += utf8.RuneCountInString(fmt.Sprint(arg))
+	}
+	return length <= p.maxLineLength
+}
+
 // In adds one indent.
 func (p *printer) In() {
 	p.indentCount++
 }
 
+// PBlankLines prints the blank lines between top-level elements.
+func (p *printer) PBlankLines() {
+	for i := 0; i < p.topLevelBlankLines; i++ {
+		p.P()
+	}
+}
+
 // Out deletes one indent.
 func (p *printer) Out() {
 	// might want to error
@@ -23,23 +23,36 @@ package format
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"text/scanner"
 
 	"github.com/emicklei/proto"
 	"github.com/uber/prototool/internal/text"
 	"go.uber.org/zap"
 )
 
+// parseErrorRegexp matches the errors from the parser, such as
+// "<input>:3:1: found ..." and "go scanner error at <input>:3:1 = ...".
+var parseErrorRegexp = regexp.MustCompile(`^(?:go scanner error at )?<input>:(\d+):(\d+)(?::| =) (.*)$`)
+
 type transformer struct {
-	logger     *zap.Logger
-	fix        int
-	fileHeader string
+	logger         *zap.Logger
+	fix            int
+	fileHeader     string
+	canonicalOrder bool
+	removeImports  map[string]struct{}
+	addImports     []string
+	modifier       func(*proto.Proto) []*text.Failure
+	printOptions   printOptions
 }
 
 func newTransformer(options ...TransformerOption) *transformer {
 	transformer := &transformer{
-		logger: zap.NewNop(),
-		fix:    FixNone,
+		logger:       zap.NewNop(),
+		fix:          FixNone,
+		printOptions: newPrintOptions(),
 	}
 	for _, option := range options {
 		option(transformer)
@@ -53,15 +66,32 @@ func (t *transformer) Transform(filename string, data []byte) ([]byte, []*text.F
 	}
 	descriptor, err := proto.NewParser(bytes.NewReader(data)).Parse()
 	if err != nil {
+		if failures := getParseFailures(err); len(failures) > 0 {
+			return nil, failures, nil
+		}
 		return nil, nil, err
 	}
 	descriptor.Filename = filename
+	var failures []*text.Failure
+	if t.modifier != nil {
+		failures = append(failures, t.modifier(descriptor)...)
+	}
+	if t.fix != FixNone && t.canonicalOrder {
+		descriptor.Elements = canonicalOrder(descriptor.Elements)
+	}
+
+	printOptions := t.printOptions
+	// /* */ comments are always printed as // comments, but only
+	// cleaned up when fixing as this can change the comment text
+	printOptions.fixCStyleComments = t.fix != FixNone
 
-	firstPassVisitor := newFirstPassVisitor(filename, t.fix, t.fileHeader)
+	firstPassVisitor := newFirstPassVisitor(filename, t.fix, t.fileHeader, printOptions)
+	firstPassVisitor.removeImports = t.removeImports
+	firstPassVisitor.addImports = t.addImports
 	for _, element := range descriptor.Elements {
 		element.Accept(firstPassVisitor)
 	}
-	failures := firstPassVisitor.Do()
+	failures = append(failures, firstPassVisitor.Do()...)
 	buffer := bytes.NewBuffer(nil)
 	buffer.Write(firstPassVisitor.Bytes())
 
@@ -77,7 +107,7 @@ func (t *transformer) Transform(filename string, data []byte) ([]byte, []*text.F
 		}
 	}
 
-	mainVisitor := newMainVisitor(syntaxVersion == 2)
+	mainVisitor := newMainVisitor(syntaxVersion == 2, printOptions)
 	for _, element := range descriptor.Elements {
 		element.Accept(mainVisitor)
 	}
@@ -102,3 +132,31 @@ func checkFix(fix int) error {
 		return fmt.Errorf("unknown format fix value: %d", fix)
 	}
 }
+
+// getParseFailures returns a failure for each error in the error returned
+// from parsing a file, or nil if the error is not a parse error.
+//
+// The parser does not have a filename, so positions are printed as
+// <input>:line:column, and scanner errors are printed one per line.
+func getParseFailures(err error) []*text.Failure {
+	var failures []*text.Failure
+	for _, line := range strings.Split(strings.TrimSpace(err.Error()), "\n") {
+		matches := parseErrorRegexp.FindStringSubmatch(line)
+		if matches == nil {
+			return nil
+		}
+		lineNumber, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil
+		}
+		column, err := strconv.Atoi(matches[2])
+		if err != nil {
+			return nil
+		}
+		failures = append(failures, text.NewFailuref(scanner.Position{
+			Line:   lineNumber,
+			Column: column,
+		}, "INVALID_PROTOBUF", "%s", matches[3]))
+	}
+	return failures
+}
@@ -22,12 +22,16 @@
 package git
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/uber/prototool/internal/file"
 	"go.uber.org/zap"
@@ -66,3 +70,66 @@ func TemporaryClone(logger *zap.Logger, dirPath string, branchOrTag string) (str
 	}
 	return cloneDirPath, nil
 }
+
+// BlameLineTimes returns the author time of each line of the file at the
+// given path, keyed by line number starting at 1.
+//
+// Lines that are not committed yet have the current time. An error is
+// returned if the file is not tracked in a git repository.
+func BlameLineTimes(filePath string) (map[int]time.Time, error) {
+	absFilePath, err := file.AbsClean(filePath)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{"blame", "--porcelain", "--", filepath.Base(absFilePath)}
+	cmd := exec.Command("git", args...)
+	cmd.Dir = filepath.Dir(absFilePath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("git %s had error: %s", strings.Join(args, " "), string(output))
+	}
+	return parseBlamePorcelain(output)
+}
+
+// parseBlamePorcelain parses the output of git blame --porcelain.
+//
+// Each line of the file is preceded by a header line of the form
+// "<commit> <original line> <final line> [<group size>]", and the first
+// header of each commit is followed by information about the commit,
+// including "author-time <unix time>".
+func parseBlamePorcelain(output []byte) (map[int]time.Time, error) {
+	commitToTime := make(map[string]time.Time)
+	lineToTime := make(map[int]time.Time)
+	var commit string
+	var finalLine int
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "\t"):
+			lineToTime[finalLine] = commitToTime[commit]
+		case strings.HasPrefix(line, "author-time "):
+			unixTime, err := strconv.ParseInt(strings.TrimPrefix(line, "author-time "), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse git blame line %q: %v", line, err)
+			}
+			commitToTime[commit] = time.Unix(unixTime, 0)
+		default:
+			fields := strings.Fields(line)
+			if len(fields) < 3 || len(fields[0]) != 40 {
+				continue
+			}
+			n, err := strconv.Atoi(fields[2])
+			if err != nil {
+				continue
+			}
+			commit = fields[0]
+			finalLine = n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lineToTime, nil
+}
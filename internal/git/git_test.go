@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package git
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlamePorcelain(t *testing.T) {
+	lineToTime, err := parseBlamePorcelain([]byte(`1111111111111111111111111111111111111111 1 1 2
+author Foo
+author-time 1500000000
+author-tz +0000
+summary Add foo
+filename foo.proto
+	syntax = "proto3";
+1111111111111111111111111111111111111111 2 2
+	
+2222222222222222222222222222222222222222 2 3 1
+author Bar
+author-time 1600000000
+author-tz +0000
+summary Add bar
+previous 1111111111111111111111111111111111111111 foo.proto
+filename foo.proto
+	package foo;
+`))
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		map[int]time.Time{
+			1: time.Unix(1500000000, 0),
+			2: time.Unix(1500000000, 0),
+			3: time.Unix(1600000000, 0),
+		},
+		lineToTime,
+	)
+}
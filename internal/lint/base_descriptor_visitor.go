@@ -0,0 +1,148 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"text/scanner"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/uber/prototool/internal/location"
+	"github.com/uber/prototool/internal/text"
+)
+
+// descriptorVisitor visits the elements of a compiled FileDescriptorProto.
+// Each element is given with its location path, which can be used with the
+// Finder of the FileDescriptor to get its position and comments.
+//
+// descriptorVisitors are expected to be called with one file at a time,
+// and are not thread-safe.
+type descriptorVisitor interface {
+	// OnStart is called when visiting is started.
+	OnStart(*FileDescriptor) error
+	VisitMessage(location.Path, *descriptor.DescriptorProto)
+	VisitField(location.Path, *descriptor.FieldDescriptorProto)
+	VisitEnum(location.Path, *descriptor.EnumDescriptorProto)
+	VisitEnumValue(location.Path, *descriptor.EnumValueDescriptorProto)
+	VisitService(location.Path, *descriptor.ServiceDescriptorProto)
+	VisitMethod(location.Path, *descriptor.MethodDescriptorProto)
+	// Finally is called when visiting is done.
+	Finally() error
+}
+
+var _ descriptorVisitor = baseDescriptorVisitor{}
+
+type baseDescriptorVisitor struct{}
+
+func (baseDescriptorVisitor) OnStart(*FileDescriptor) error                                      { return nil }
+func (baseDescriptorVisitor) Finally() error                                                     { return nil }
+func (baseDescriptorVisitor) VisitMessage(location.Path, *descriptor.DescriptorProto)            {}
+func (baseDescriptorVisitor) VisitField(location.Path, *descriptor.FieldDescriptorProto)         {}
+func (baseDescriptorVisitor) VisitEnum(location.Path, *descriptor.EnumDescriptorProto)           {}
+func (baseDescriptorVisitor) VisitEnumValue(location.Path, *descriptor.EnumValueDescriptorProto) {}
+func (baseDescriptorVisitor) VisitService(location.Path, *descriptor.ServiceDescriptorProto)     {}
+func (baseDescriptorVisitor) VisitMethod(location.Path, *descriptor.MethodDescriptorProto)       {}
+
+type baseAddDescriptorVisitor struct {
+	baseDescriptorVisitor
+	fileDescriptor *FileDescriptor
+	add            func(*text.Failure)
+}
+
+func newBaseAddDescriptorVisitor(add func(*text.Failure)) *baseAddDescriptorVisitor {
+	return &baseAddDescriptorVisitor{add: add}
+}
+
+func (v *baseAddDescriptorVisitor) OnStart(fileDescriptor *FileDescriptor) error {
+	v.fileDescriptor = fileDescriptor
+	return nil
+}
+
+// AddFailuref adds a failure at the position of the element with the
+// given location path.
+func (v *baseAddDescriptorVisitor) AddFailuref(path location.Path, format string, args ...interface{}) {
+	v.add(text.NewFailuref(v.getPosition(path), "", format, args...))
+}
+
+// getPosition returns the position of the element with the given location
+// path. If the element has no location, the position of the closest
+// enclosing element that has one is used instead.
+func (v *baseAddDescriptorVisitor) getPosition(path location.Path) scanner.Position {
+	position := scanner.Position{
+		Filename: v.fileDescriptor.Filename,
+		Line:     1,
+		Column:   1,
+	}
+	for ; len(path) > 0; path = path[:len(path)-1] {
+		if loc, ok := v.fileDescriptor.Finder.Find(path); ok {
+			position.Line = int(loc.Span.Line())
+			position.Column = int(loc.Span.Col())
+			break
+		}
+	}
+	return position
+}
+
+func runDescriptorVisitor(visitor descriptorVisitor, descriptors []*FileDescriptor) error {
+	for _, fileDescriptor := range descriptors {
+		if err := visitor.OnStart(fileDescriptor); err != nil {
+			return err
+		}
+		fileDescriptorProto := fileDescriptor.FileDescriptorProto
+		var path location.Path
+		for i, message := range fileDescriptorProto.GetMessageType() {
+			visitDescriptorMessage(visitor, path.Scope(location.Message, i), message)
+		}
+		for i, enum := range fileDescriptorProto.GetEnumType() {
+			visitDescriptorEnum(visitor, path.Scope(location.Enum, i), enum)
+		}
+		for i, service := range fileDescriptorProto.GetService() {
+			servicePath := path.Scope(location.Service, i)
+			visitor.VisitService(servicePath, service)
+			for j, method := range service.GetMethod() {
+				visitor.VisitMethod(servicePath.Scope(location.Method, j), method)
+			}
+		}
+		if err := visitor.Finally(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func visitDescriptorMessage(visitor descriptorVisitor, path location.Path, message *descriptor.DescriptorProto) {
+	visitor.VisitMessage(path, message)
+	for i, field := range message.GetField() {
+		visitor.VisitField(path.Scope(location.Field, i), field)
+	}
+	for i, nestedMessage := range message.GetNestedType() {
+		visitDescriptorMessage(visitor, path.Scope(location.NestedType, i), nestedMessage)
+	}
+	for i, enum := range message.GetEnumType() {
+		visitDescriptorEnum(visitor, path.Scope(location.MessageEnum, i), enum)
+	}
+}
+
+func visitDescriptorEnum(visitor descriptorVisitor, path location.Path, enum *descriptor.EnumDescriptorProto) {
+	visitor.VisitEnum(path, enum)
+	for i, value := range enum.GetValue() {
+		visitor.VisitEnumValue(path.Scope(location.EnumValue, i), value)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/prototool/internal/text"
+)
+
+func TestDescriptorLinterPositions(t *testing.T) {
+	failures := checkTestDocExample(t, wktFieldSuffixesLinter, `
+// File: foo/v1/foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+
+message Foo {
+  message Nested {
+    Bar.Created created = 1;
+  }
+  oneof value {
+    Bar.Created timestamp = 2;
+  }
+}
+// File: foo/v1/bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Bar {
+  message Created {}
+  google.protobuf.Timestamp created = 1;
+}
+`)
+	// Bar.Created is not a google.protobuf.Timestamp even though the name
+	// matches, so the only failure is in bar.proto
+	require.Len(t, failures, 1)
+	assert.Equal(t, &text.Failure{
+		Filename: "foo/v1/bar.proto",
+		Line:     9,
+		Column:   3,
+		LintID:   "WKT_FIELD_SUFFIXES",
+		Message:  `Field "created" of type "google.protobuf.Timestamp" must be named "time" or end in "_time".`,
+	}, failures[0])
+}
+
+func TestDescriptorLinterNeedsFileDescriptorProto(t *testing.T) {
+	assert.True(t, NeedsFileDescriptorSets([]Linter{WithDefaultSeverity(wktFieldSuffixesLinter, text.SeverityWarning)}))
+	assert.False(t, NeedsFileDescriptorSets([]Linter{wktTimestampSuffixLinter}))
+	_, err := wktFieldSuffixesLinter.Check(testDocDirPath, []*FileDescriptor{{Path: "/example/foo.proto"}})
+	assert.Error(t, err)
+}
+
+func TestFileDescriptorProtoMap(t *testing.T) {
+	fileDescriptorProtos := newFileDescriptorProtoMap([]*descriptor.FileDescriptorSet{
+		{
+			File: []*descriptor.FileDescriptorProto{
+				{Name: proto.String("foo.proto")},
+				{Name: proto.String("v1/foo.proto")},
+			},
+		},
+	})
+	fileDescriptorProto, ok := fileDescriptorProtos.get("/example/foo/v1/foo.proto")
+	require.True(t, ok)
+	assert.Equal(t, "v1/foo.proto", fileDescriptorProto.GetName())
+	fileDescriptorProto, ok = fileDescriptorProtos.get("/example/foo.proto")
+	require.True(t, ok)
+	assert.Equal(t, "foo.proto", fileDescriptorProto.GetName())
+	_, ok = fileDescriptorProtos.get("/example/bar.proto")
+	assert.False(t, ok)
+	_, ok = newFileDescriptorProtoMap(nil).get("/example/foo.proto")
+	assert.False(t, ok)
+}
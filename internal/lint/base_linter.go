@@ -87,6 +87,14 @@ func (c *baseLinter) Purpose(config settings.LintConfig) string {
 	return c.purpose
 }
 
+func (c *baseLinter) DefaultSeverity() string {
+	return text.SeverityError
+}
+
+func (c *baseLinter) Doc() *Doc {
+	return _idToDoc[c.id]
+}
+
 func (c *baseLinter) Check(dirPath string, descriptors []*FileDescriptor) ([]*text.Failure, error) {
 	var failures []*text.Failure
 	err := c.addCheck(
@@ -130,3 +138,68 @@ func (c *baseLinter) isSuppressed(protoSet *file.ProtoSet, comment *proto.Commen
 	}
 	return false
 }
+
+type protoSetLinter struct {
+	*baseLinter
+	addCheckProtoSet func(func(*text.Failure), map[string][]*FileDescriptor) error
+}
+
+func newProtoSetLinter(
+	id string,
+	purpose string,
+	addCheck func(func(*text.Failure), map[string][]*FileDescriptor) error,
+) *protoSetLinter {
+	return &protoSetLinter{
+		baseLinter: newBaseLinter(
+			id,
+			purpose,
+			func(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+				return addCheck(add, map[string][]*FileDescriptor{dirPath: descriptors})
+			},
+		),
+		addCheckProtoSet: addCheck,
+	}
+}
+
+func (c *protoSetLinter) CheckProtoSet(dirPathToDescriptors map[string][]*FileDescriptor) ([]*text.Failure, error) {
+	var failures []*text.Failure
+	err := c.addCheckProtoSet(
+		func(failure *text.Failure) {
+			failures = append(failures, failure)
+		},
+		dirPathToDescriptors,
+	)
+	for _, failure := range failures {
+		failure.LintID = c.id
+	}
+	return failures, err
+}
+
+type descriptorLinter struct {
+	*baseLinter
+}
+
+func newDescriptorLinter(
+	id string,
+	purpose string,
+	addCheck func(func(*text.Failure), string, []*FileDescriptor) error,
+) *descriptorLinter {
+	return &descriptorLinter{
+		baseLinter: newBaseLinter(
+			id,
+			purpose,
+			func(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+				for _, descriptor := range descriptors {
+					if descriptor.FileDescriptorProto == nil {
+						return fmt.Errorf("linter %s needs the compiled file descriptor for %s", strings.ToUpper(id), descriptor.Path)
+					}
+				}
+				return addCheck(add, dirPath, descriptors)
+			},
+		),
+	}
+}
+
+func (c *descriptorLinter) needsFileDescriptorSets() bool {
+	return true
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/settings"
+	"github.com/uber/prototool/internal/text"
+	"github.com/uber/prototool/internal/vars"
+)
+
+// uncachedLinterIDs are the IDs of the linters whose failures do not only
+// depend on the file contents and the configuration. The failures of these
+// linters are never cached.
+var uncachedLinterIDs = map[string]struct{}{
+	deprecationsNotExpiredLinter.ID(): {},
+}
+
+// importRegexp matches the import statements of a file.
+var importRegexp = regexp.MustCompile(`(?m)^\s*import\s+(?:public\s+|weak\s+)?"([^"]+)"\s*;`)
+
+// resultCache is an on-disk cache of lint failures.
+//
+// Entries are keyed by the hashes of the contents of the linted files,
+// along with the configuration, the enabled linters and the prototool
+// version, so entries never need to be invalidated.
+//
+// The keys for all directories together also include the hashes of the
+// contents of the files that are transitively imported from outside of the
+// linted files, as these are compiled along with the linted files and can
+// change whether the linted files compile.
+type resultCache struct {
+	dirPath    string
+	baseKey    []byte
+	importsKey string
+}
+
+func newResultCache(cachePath string, protoSet *file.ProtoSet, linters []Linter) (*resultCache, error) {
+	configData, err := json.Marshal(
+		struct {
+			ExcludePrefixes []string
+			Compile         settings.CompileConfig
+			Lint            settings.LintConfig
+		}{
+			ExcludePrefixes: protoSet.Config.ExcludePrefixes,
+			Compile:         protoSet.Config.Compile,
+			Lint:            protoSet.Config.Lint,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	linterIDs := make([]string, 0, len(linters))
+	for _, linter := range linters {
+		linterIDs = append(linterIDs, linter.ID())
+	}
+	sort.Strings(linterIDs)
+	hash := sha256.New()
+	writeCacheKeyParts(hash, vars.Version, string(configData))
+	writeCacheKeyParts(hash, linterIDs...)
+	importsKey, err := getImportsKey(protoSet)
+	if err != nil {
+		return nil, err
+	}
+	return &resultCache{
+		dirPath:    filepath.Join(cachePath, "lint"),
+		baseKey:    hash.Sum(nil),
+		importsKey: importsKey,
+	}, nil
+}
+
+// getDirKey returns the key for the failures of the directory linters
+// for the given files.
+func (c *resultCache) getDirKey(protoFiles []*file.ProtoFile) (string, error) {
+	sortedProtoFiles := make([]*file.ProtoFile, len(protoFiles))
+	copy(sortedProtoFiles, protoFiles)
+	sort.Slice(sortedProtoFiles, func(i int, j int) bool { return sortedProtoFiles[i].Path < sortedProtoFiles[j].Path })
+	hash := c.newHash("dir")
+	for _, protoFile := range sortedProtoFiles {
+		data, err := ioutil.ReadFile(protoFile.Path)
+		if err != nil {
+			return "", err
+		}
+		fileHash := sha256.Sum256(data)
+		writeCacheKeyParts(hash, protoFile.Path, protoFile.DisplayPath, hex.EncodeToString(fileHash[:]))
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getProtoSetKey returns the key of the given kind for all directories,
+// given the key of each directory from getDirKey.
+func (c *resultCache) getProtoSetKey(kind string, dirPathToKey map[string]string) string {
+	dirPaths := make([]string, 0, len(dirPathToKey))
+	for dirPath := range dirPathToKey {
+		dirPaths = append(dirPaths, dirPath)
+	}
+	sort.Strings(dirPaths)
+	hash := c.newHash(kind)
+	for _, dirPath := range dirPaths {
+		writeCacheKeyParts(hash, dirPath, dirPathToKey[dirPath])
+	}
+	writeCacheKeyParts(hash, c.importsKey)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// get returns the cached failures for the key, or false if there is no
+// valid entry for the key.
+func (c *resultCache) get(key string) ([]*text.Failure, bool) {
+	data, err := ioutil.ReadFile(c.getPath(key))
+	if err != nil {
+		return nil, false
+	}
+	var failures []*text.Failure
+	if err := json.Unmarshal(data, &failures); err != nil {
+		return nil, false
+	}
+	return failures, true
+}
+
+// put stores the failures for the key.
+//
+// The entry is written to a temporary file first, so that concurrent
+// runs never read a partial entry.
+func (c *resultCache) put(key string, failures []*text.Failure) (retErr error) {
+	if failures == nil {
+		failures = []*text.Failure{}
+	}
+	data, err := json.Marshal(failures)
+	if err != nil {
+		return err
+	}
+	path := c.getPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tempFile, err := ioutil.TempFile(filepath.Dir(path), key)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			_ = os.Remove(tempFile.Name())
+		}
+	}()
+	if _, err := tempFile.Write(data); err != nil {
+		_ = tempFile.Close()
+		return err
+	}
+	if err := tempFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tempFile.Name(), path)
+}
+
+func (c *resultCache) getPath(key string) string {
+	return filepath.Join(c.dirPath, key[:2], key+".json")
+}
+
+func (c *resultCache) newHash(kind string) hash.Hash {
+	hash := sha256.New()
+	_, _ = hash.Write(c.baseKey)
+	writeCacheKeyParts(hash, kind)
+	return hash
+}
+
+// getImportsKey returns the hash of the contents of the files that the
+// linted files transitively import from outside of the linted files.
+//
+// Imports are resolved against the include paths in the same order as
+// protoc. Imports that cannot be resolved, such as the Well-Known Types,
+// are hashed by name only, as the Well-Known Types only change with the
+// protoc version, which is part of the configuration.
+func getImportsKey(protoSet *file.ProtoSet) (string, error) {
+	lintedPaths := make(map[string]struct{})
+	var paths []string
+	for dirPath, protoFiles := range protoSet.DirPathToFiles {
+		if !strings.HasPrefix(dirPath, protoSet.DirPath) {
+			continue
+		}
+		for _, protoFile := range protoFiles {
+			lintedPaths[protoFile.Path] = struct{}{}
+			paths = append(paths, protoFile.Path)
+		}
+	}
+	includePaths := getImportIncludePaths(protoSet)
+	importToHash := make(map[string]string)
+	seenPaths := make(map[string]struct{}, len(paths))
+	for len(paths) > 0 {
+		path := paths[0]
+		paths = paths[1:]
+		if _, ok := seenPaths[path]; ok {
+			continue
+		}
+		seenPaths[path] = struct{}{}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		for _, match := range importRegexp.FindAllSubmatch(data, -1) {
+			importPath := string(match[1])
+			if _, ok := importToHash[importPath]; ok {
+				continue
+			}
+			importToHash[importPath] = ""
+			resolvedPath, ok := resolveImport(includePaths, importPath)
+			if !ok {
+				continue
+			}
+			if _, ok := lintedPaths[resolvedPath]; ok {
+				// already part of the key of its directory
+				continue
+			}
+			importData, err := ioutil.ReadFile(resolvedPath)
+			if err != nil {
+				return "", err
+			}
+			importHash := sha256.Sum256(importData)
+			importToHash[importPath] = resolvedPath + ":" + hex.EncodeToString(importHash[:])
+			paths = append(paths, resolvedPath)
+		}
+	}
+	importPaths := make([]string, 0, len(importToHash))
+	for importPath := range importToHash {
+		importPaths = append(importPaths, importPath)
+	}
+	sort.Strings(importPaths)
+	hash := sha256.New()
+	for _, importPath := range importPaths {
+		writeCacheKeyParts(hash, importPath, importToHash[importPath])
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getImportIncludePaths returns the include paths that protoc resolves
+// the imports of the ProtoSet against, other than the Well-Known Types.
+func getImportIncludePaths(protoSet *file.ProtoSet) []string {
+	configDirPath := protoSet.Config.DirPath
+	if configDirPath == "" {
+		configDirPath = protoSet.WorkDirPath
+	}
+	includePaths := protoSet.Config.Compile.IncludePaths
+	for _, includePath := range includePaths {
+		if includePath == configDirPath {
+			return includePaths
+		}
+	}
+	return append(append([]string{}, includePaths...), configDirPath)
+}
+
+// resolveImport returns the path of the file for the import path in the
+// first include path that has it.
+func resolveImport(includePaths []string, importPath string) (string, bool) {
+	for _, includePath := range includePaths {
+		path := filepath.Join(includePath, importPath)
+		if fileInfo, err := os.Stat(path); err == nil && fileInfo.Mode().IsRegular() {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func writeCacheKeyParts(hash hash.Hash, parts ...string) {
+	for _, part := range parts {
+		_, _ = fmt.Fprintf(hash, "%d:%s\n", len(part), part)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"regexp"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var commentsNoTodosLinter = NewLinter(
+	"COMMENTS_NO_TODOS",
+	`Verifies that the comments on messages, fields, oneofs, enums, enum values, services and RPCs have no TODO or FIXME markers.`,
+	checkCommentsNoTodos,
+)
+
+var commentTodoRegexp = regexp.MustCompile(`\b(TODO|FIXME)\b`)
+
+func checkCommentsNoTodos(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(newNamedElementVisitor(add, func(v baseAddVisitor, element *namedElement) {
+		for _, comment := range []*proto.Comment{element.comment, element.inlineComment} {
+			forEachCommentLine(comment, func(position scanner.Position, line string) {
+				if marker := commentTodoRegexp.FindString(line); marker != "" {
+					v.AddFailuref(position, "Comment on %s %q has a %s marker, which should be tracked outside of the public API documentation.", element.kind, element.name, marker)
+				}
+			})
+		}
+	}), descriptors)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/uber/prototool/internal/text"
+)
+
+var commentsNotRestatingNamesLinter = NewLinter(
+	"COMMENTS_NOT_RESTATING_NAMES",
+	`Verifies that the comments on messages, fields, oneofs, enums, enum values, services and RPCs do not only restate the name of the element.`,
+	checkCommentsNotRestatingNames,
+)
+
+// commentFillerWords are the words that are ignored when checking if a
+// comment only restates the name of its element.
+var commentFillerWords = map[string]struct{}{
+	"a":          {},
+	"an":         {},
+	"and":        {},
+	"are":        {},
+	"be":         {},
+	"by":         {},
+	"contains":   {},
+	"defines":    {},
+	"definition": {},
+	"describes":  {},
+	"enum":       {},
+	"field":      {},
+	"for":        {},
+	"holds":      {},
+	"in":         {},
+	"is":         {},
+	"it":         {},
+	"its":        {},
+	"message":    {},
+	"method":     {},
+	"of":         {},
+	"on":         {},
+	"oneof":      {},
+	"or":         {},
+	"represents": {},
+	"rpc":        {},
+	"service":    {},
+	"specifies":  {},
+	"that":       {},
+	"the":        {},
+	"this":       {},
+	"to":         {},
+	"type":       {},
+	"value":      {},
+	"with":       {},
+}
+
+func checkCommentsNotRestatingNames(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(newNamedElementVisitor(add, func(v baseAddVisitor, element *namedElement) {
+		if element.comment == nil {
+			return
+		}
+		nameWords := map[string]struct{}{
+			normalizeRestatedWord(strings.Replace(element.name, "_", "", -1)): {},
+		}
+		for _, word := range getNameWords(element.name) {
+			nameWords[normalizeRestatedWord(word)] = struct{}{}
+		}
+		restatesName := false
+		for _, line := range element.comment.Lines {
+			for _, word := range strings.FieldsFunc(line, func(r rune) bool { return !unicode.IsLetter(r) }) {
+				if _, ok := commentFillerWords[strings.ToLower(word)]; ok {
+					continue
+				}
+				if _, ok := nameWords[normalizeRestatedWord(word)]; !ok {
+					return
+				}
+				restatesName = true
+			}
+		}
+		if restatesName {
+			v.AddFailuref(element.comment.Position, "Comment on %s %q only restates the name, and should explain what the %s is for instead.", element.kind, element.name, element.kind)
+		}
+	}), descriptors)
+}
+
+// normalizeRestatedWord returns the lowercase word without a plural or
+// third-person "s", so that "Lists users" restates "ListUser".
+func normalizeRestatedWord(word string) string {
+	word = strings.ToLower(word)
+	if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
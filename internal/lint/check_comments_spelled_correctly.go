@@ -0,0 +1,192 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"regexp"
+	"strings"
+	"text/scanner"
+	"unicode"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/spell"
+	"github.com/uber/prototool/internal/text"
+)
+
+var commentsSpelledCorrectlyLinter = NewLinter(
+	"COMMENTS_SPELLED_CORRECTLY",
+	`Verifies that the comments on all elements have no misspelled words, using the bundled English wordlist and the dictionary in lint.dictionary.path.`,
+	checkCommentsSpelledCorrectly,
+)
+
+var commentBackquotedRegexp = regexp.MustCompile("`[^`]*`")
+
+func checkCommentsSpelledCorrectly(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	checker := getSpellChecker(descriptors)
+	return runVisitor(newNamedElementVisitor(add, func(v baseAddVisitor, element *namedElement) {
+		for _, comment := range []*proto.Comment{element.comment, element.inlineComment} {
+			forEachCommentLine(comment, func(position scanner.Position, line string) {
+				for _, word := range getCommentWords(line) {
+					if !checker.IsCorrect(word) {
+						v.AddFailuref(position, "Comment on %s %q has the misspelled word %q.", element.kind, element.name, word)
+					}
+				}
+			})
+		}
+	}), descriptors)
+}
+
+// getSpellChecker returns a spell.Checker with the words of the
+// dictionary configured for the descriptors.
+func getSpellChecker(descriptors []*FileDescriptor) spell.Checker {
+	if len(descriptors) == 0 {
+		return spell.NewChecker()
+	}
+	return spell.NewChecker(spell.CheckerWithWords(descriptors[0].ProtoSet.Config.Lint.DictionaryWords...))
+}
+
+// forEachCommentLine calls f with the position and text of each line of
+// the comment. The comment can be nil.
+func forEachCommentLine(comment *proto.Comment, f func(scanner.Position, string)) {
+	if comment == nil {
+		return
+	}
+	for i, line := range comment.Lines {
+		position := comment.Position
+		position.Line += i
+		f(position, line)
+	}
+}
+
+// getCommentWords returns the words of a comment line that should be
+// spellchecked.
+//
+// Backquoted text, URLs, and words with uppercase letters after the first
+// letter such as identifiers and acronyms are skipped.
+func getCommentWords(line string) []string {
+	var words []string
+	seen := make(map[string]struct{})
+	for _, field := range strings.Fields(commentBackquotedRegexp.ReplaceAllString(line, " ")) {
+		if strings.Contains(field, "://") || strings.HasPrefix(field, "www.") {
+			continue
+		}
+		for _, word := range strings.Split(strings.Trim(field, `.,;:!?()[]{}"'*<>`), "-") {
+			if !isCommentWord(word) {
+				continue
+			}
+			if _, ok := seen[word]; ok {
+				continue
+			}
+			seen[word] = struct{}{}
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+func isCommentWord(word string) bool {
+	if word == "" {
+		return false
+	}
+	for i, r := range word {
+		if r == '\'' {
+			continue
+		}
+		if !unicode.IsLetter(r) || (i > 0 && unicode.IsUpper(r)) {
+			return false
+		}
+	}
+	return true
+}
+
+// namedElement is an element with a name that can have comments, such as
+// a message, field or RPC.
+type namedElement struct {
+	// kind is the kind of element as used in failures, such as
+	// "message" or "enum value".
+	kind          string
+	name          string
+	position      scanner.Position
+	comment       *proto.Comment
+	inlineComment *proto.Comment
+}
+
+type namedElementVisitor struct {
+	baseAddVisitor
+	check func(baseAddVisitor, *namedElement)
+}
+
+func newNamedElementVisitor(add func(*text.Failure), check func(baseAddVisitor, *namedElement)) namedElementVisitor {
+	return namedElementVisitor{
+		baseAddVisitor: newBaseAddVisitor(add),
+		check:          check,
+	}
+}
+
+func (v namedElementVisitor) VisitMessage(message *proto.Message) {
+	if !message.IsExtend {
+		v.check(v.baseAddVisitor, &namedElement{kind: "message", name: message.Name, position: message.Position, comment: message.Comment})
+	}
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v namedElementVisitor) VisitNormalField(field *proto.NormalField) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "field", name: field.Name, position: field.Position, comment: field.Comment, inlineComment: field.InlineComment})
+}
+
+func (v namedElementVisitor) VisitMapField(field *proto.MapField) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "field", name: field.Name, position: field.Position, comment: field.Comment, inlineComment: field.InlineComment})
+}
+
+func (v namedElementVisitor) VisitOneof(oneof *proto.Oneof) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "oneof", name: oneof.Name, position: oneof.Position, comment: oneof.Comment})
+	for _, child := range oneof.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v namedElementVisitor) VisitOneofField(field *proto.OneOfField) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "field", name: field.Name, position: field.Position, comment: field.Comment, inlineComment: field.InlineComment})
+}
+
+func (v namedElementVisitor) VisitEnum(enum *proto.Enum) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "enum", name: enum.Name, position: enum.Position, comment: enum.Comment})
+	for _, child := range enum.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v namedElementVisitor) VisitEnumField(field *proto.EnumField) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "enum value", name: field.Name, position: field.Position, comment: field.Comment, inlineComment: field.InlineComment})
+}
+
+func (v namedElementVisitor) VisitService(service *proto.Service) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "service", name: service.Name, position: service.Position, comment: service.Comment})
+	for _, child := range service.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v namedElementVisitor) VisitRPC(rpc *proto.RPC) {
+	v.check(v.baseAddVisitor, &namedElement{kind: "RPC", name: rpc.Name, position: rpc.Position, comment: rpc.Comment, inlineComment: rpc.InlineComment})
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var deprecatedMessagesNotReferencedLinter = NewProtoSetLinter(
+	"DEPRECATED_MESSAGES_NOT_REFERENCED",
+	`Verifies that deprecated messages are only referenced by fields and RPCs that are deprecated themselves, across all packages.`,
+	checkDeprecatedMessagesNotReferenced,
+)
+
+func checkDeprecatedMessagesNotReferenced(add func(*text.Failure), dirPathToDescriptors map[string][]*FileDescriptor) error {
+	dirPaths := make([]string, 0, len(dirPathToDescriptors))
+	for dirPath := range dirPathToDescriptors {
+		dirPaths = append(dirPaths, dirPath)
+	}
+	sort.Strings(dirPaths)
+	// deprecated messages are usually referenced from other packages, so
+	// the messages of all directories are collected before any reference
+	// is checked
+	collectVisitor := &deprecatedMessagesCollectVisitor{
+		names:              make(map[string]struct{}),
+		deprecatedMessages: make(map[string]struct{}),
+	}
+	for _, dirPath := range dirPaths {
+		if err := runVisitor(collectVisitor, dirPathToDescriptors[dirPath]); err != nil {
+			return err
+		}
+	}
+	if len(collectVisitor.deprecatedMessages) == 0 {
+		return nil
+	}
+	visitor := &deprecatedMessagesNotReferencedVisitor{
+		baseAddVisitor:     newBaseAddVisitor(add),
+		names:              collectVisitor.names,
+		deprecatedMessages: collectVisitor.deprecatedMessages,
+	}
+	for _, dirPath := range dirPaths {
+		if err := runVisitor(visitor, dirPathToDescriptors[dirPath]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deprecatedMessagesCollectVisitor collects the full names of all
+// packages, messages and enums, and the full names of the deprecated
+// messages.
+type deprecatedMessagesCollectVisitor struct {
+	baseVisitor
+	names              map[string]struct{}
+	deprecatedMessages map[string]struct{}
+	scope              []string
+}
+
+func (v *deprecatedMessagesCollectVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.scope = getPackageScope(descriptor)
+	for i := 1; i <= len(v.scope); i++ {
+		v.names[strings.Join(v.scope[:i], ".")] = struct{}{}
+	}
+	return nil
+}
+
+func (v *deprecatedMessagesCollectVisitor) VisitMessage(message *proto.Message) {
+	if message.IsExtend {
+		return
+	}
+	fullName := joinFullName(strings.Join(v.scope, "."), message.Name)
+	v.names[fullName] = struct{}{}
+	if isDeprecated(message.Elements) {
+		v.deprecatedMessages[fullName] = struct{}{}
+	}
+	v.scope = append(v.scope, message.Name)
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	v.scope = v.scope[:len(v.scope)-1]
+}
+
+func (v *deprecatedMessagesCollectVisitor) VisitEnum(enum *proto.Enum) {
+	v.names[joinFullName(strings.Join(v.scope, "."), enum.Name)] = struct{}{}
+}
+
+type deprecatedMessagesNotReferencedVisitor struct {
+	baseAddVisitor
+	names              map[string]struct{}
+	deprecatedMessages map[string]struct{}
+	scope              []string
+	// the number of enclosing messages that are deprecated
+	numDeprecated int
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.scope = getPackageScope(descriptor)
+	v.numDeprecated = 0
+	return nil
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitMessage(message *proto.Message) {
+	deprecated := isDeprecated(message.Elements)
+	if deprecated {
+		v.numDeprecated++
+	}
+	if !message.IsExtend {
+		v.scope = append(v.scope, message.Name)
+	}
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	if !message.IsExtend {
+		v.scope = v.scope[:len(v.scope)-1]
+	}
+	if deprecated {
+		v.numDeprecated--
+	}
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitOneof(oneof *proto.Oneof) {
+	for _, child := range oneof.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitNormalField(field *proto.NormalField) {
+	v.checkField(field.Field)
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitOneofField(field *proto.OneOfField) {
+	v.checkField(field.Field)
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitMapField(field *proto.MapField) {
+	v.checkField(field.Field)
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitService(service *proto.Service) {
+	if isDeprecated(service.Elements) {
+		return
+	}
+	for _, child := range service.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) VisitRPC(rpc *proto.RPC) {
+	if isDeprecated(rpc.Elements) {
+		return
+	}
+	if fullName, ok := v.resolveDeprecatedMessage(rpc.RequestType); ok {
+		v.AddFailuref(rpc.Position, "RPC %q uses the deprecated message %q as its request type but is not deprecated.", rpc.Name, fullName)
+	}
+	if fullName, ok := v.resolveDeprecatedMessage(rpc.ReturnsType); ok {
+		v.AddFailuref(rpc.Position, "RPC %q uses the deprecated message %q as its response type but is not deprecated.", rpc.Name, fullName)
+	}
+}
+
+func (v *deprecatedMessagesNotReferencedVisitor) checkField(field *proto.Field) {
+	if v.numDeprecated > 0 || getDeprecatedOption(field.Options) != nil {
+		return
+	}
+	if fullName, ok := v.resolveDeprecatedMessage(field.Type); ok {
+		v.AddFailuref(field.Position, "Field %q references the deprecated message %q but is not deprecated.", field.Name, fullName)
+	}
+}
+
+// resolveDeprecatedMessage returns the full name of the type that the type
+// name refers to if it is a deprecated message.
+//
+// Like protoc, the first component of the type name is searched for from the
+// innermost scope outwards, so a nearer type with the same name shadows a
+// deprecated message in an outer scope.
+func (v *deprecatedMessagesNotReferencedVisitor) resolveDeprecatedMessage(typeName string) (string, bool) {
+	if strings.HasPrefix(typeName, ".") {
+		fullName := strings.TrimPrefix(typeName, ".")
+		_, ok := v.deprecatedMessages[fullName]
+		return fullName, ok
+	}
+	firstComponent := typeName
+	if i := strings.IndexByte(typeName, '.'); i >= 0 {
+		firstComponent = typeName[:i]
+	}
+	for i := len(v.scope); i >= 0; i-- {
+		scope := strings.Join(v.scope[:i], ".")
+		if _, ok := v.names[joinFullName(scope, firstComponent)]; ok {
+			fullName := joinFullName(scope, typeName)
+			_, ok := v.deprecatedMessages[fullName]
+			return fullName, ok
+		}
+	}
+	return "", false
+}
+
+// getPackageScope returns the components of the package of the file.
+func getPackageScope(descriptor *FileDescriptor) []string {
+	for _, element := range descriptor.Elements {
+		if pkg, ok := element.(*proto.Package); ok && pkg.Name != "" {
+			return strings.Split(pkg.Name, ".")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var deprecationsHaveCommentsLinter = NewLinter(
+	"DEPRECATIONS_HAVE_COMMENTS",
+	`Verifies that every deprecated message, enum, enum value, field, service and RPC has a comment with a "Deprecated:" paragraph explaining what to use instead.`,
+	checkDeprecationsHaveComments,
+)
+
+func checkDeprecationsHaveComments(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(newDeprecationVisitor(add, checkDeprecationsHaveCommentsDeprecation), descriptors)
+}
+
+func checkDeprecationsHaveCommentsDeprecation(v baseAddVisitor, deprecation *deprecation) {
+	if deprecation.comment != nil {
+		for _, line := range deprecation.comment.Lines {
+			if strings.HasPrefix(strings.TrimSpace(line), "Deprecated:") {
+				return
+			}
+		}
+	}
+	v.AddFailuref(deprecation.position, `%s %q is deprecated but does not have a comment with a "Deprecated:" paragraph explaining what to use instead.`, deprecation.kind, deprecation.name)
+}
+
+// deprecation is an element with the "deprecated = true" option.
+type deprecation struct {
+	kind     string
+	name     string
+	position scanner.Position
+	comment  *proto.Comment
+	option   *proto.Option
+}
+
+// deprecationVisitor calls check for each deprecated element.
+type deprecationVisitor struct {
+	baseAddVisitor
+	check func(baseAddVisitor, *deprecation)
+}
+
+func newDeprecationVisitor(add func(*text.Failure), check func(baseAddVisitor, *deprecation)) deprecationVisitor {
+	return deprecationVisitor{
+		baseAddVisitor: newBaseAddVisitor(add),
+		check:          check,
+	}
+}
+
+func (v deprecationVisitor) VisitMessage(message *proto.Message) {
+	v.checkElements("Message", message.Name, message.Position, message.Comment, message.Elements)
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v deprecationVisitor) VisitEnum(enum *proto.Enum) {
+	v.checkElements("Enum", enum.Name, enum.Position, enum.Comment, enum.Elements)
+	for _, child := range enum.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v deprecationVisitor) VisitEnumField(enumField *proto.EnumField) {
+	v.checkElements("Enum value", enumField.Name, enumField.Position, enumField.Comment, enumField.Elements)
+}
+
+func (v deprecationVisitor) VisitService(service *proto.Service) {
+	v.checkElements("Service", service.Name, service.Position, service.Comment, service.Elements)
+	for _, child := range service.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v deprecationVisitor) VisitRPC(rpc *proto.RPC) {
+	v.checkElements("RPC", rpc.Name, rpc.Position, rpc.Comment, rpc.Elements)
+}
+
+func (v deprecationVisitor) VisitOneof(oneof *proto.Oneof) {
+	for _, child := range oneof.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v deprecationVisitor) VisitNormalField(field *proto.NormalField) {
+	v.checkField(field.Field)
+}
+
+func (v deprecationVisitor) VisitOneofField(field *proto.OneOfField) {
+	v.checkField(field.Field)
+}
+
+func (v deprecationVisitor) VisitMapField(field *proto.MapField) {
+	v.checkField(field.Field)
+}
+
+func (v deprecationVisitor) checkField(field *proto.Field) {
+	if option := getDeprecatedOption(field.Options); option != nil {
+		v.check(v.baseAddVisitor, &deprecation{
+			kind:     "Field",
+			name:     field.Name,
+			position: field.Position,
+			comment:  field.Comment,
+			option:   option,
+		})
+	}
+}
+
+func (v deprecationVisitor) checkElements(kind string, name string, position scanner.Position, comment *proto.Comment, elements []proto.Visitee) {
+	var options []*proto.Option
+	for _, element := range elements {
+		if option, ok := element.(*proto.Option); ok {
+			options = append(options, option)
+		}
+	}
+	if option := getDeprecatedOption(options); option != nil {
+		v.check(v.baseAddVisitor, &deprecation{
+			kind:     kind,
+			name:     name,
+			position: position,
+			comment:  comment,
+			option:   option,
+		})
+	}
+}
+
+// getDeprecatedOption returns the "deprecated = true" option, or nil if
+// there is no such option.
+func getDeprecatedOption(options []*proto.Option) *proto.Option {
+	for _, option := range options {
+		if option.Name == "deprecated" && option.Constant.Source == "true" {
+			return option
+		}
+	}
+	return nil
+}
+
+// isDeprecated returns true if the elements contain the
+// "deprecated = true" option.
+func isDeprecated(elements []proto.Visitee) bool {
+	for _, element := range elements {
+		if option, ok := element.(*proto.Option); ok && getDeprecatedOption([]*proto.Option{option}) != nil {
+			return true
+		}
+	}
+	return false
+}
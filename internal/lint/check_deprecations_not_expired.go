@@ -0,0 +1,85 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/uber/prototool/internal/git"
+	"github.com/uber/prototool/internal/text"
+)
+
+var deprecationsNotExpiredLinter = WithDefaultSeverity(
+	NewLinter(
+		"DEPRECATIONS_NOT_EXPIRED",
+		`Verifies that no element has been deprecated for longer than lint.deprecations.max_age if set in the configuration file, according to git blame.`,
+		checkDeprecationsNotExpired,
+	),
+	text.SeverityWarning,
+)
+
+var (
+	// getDeprecationLineTimes returns the time each line of a file was last
+	// changed, keyed by line number. Overridden in tests.
+	getDeprecationLineTimes = git.BlameLineTimes
+	// getDeprecationNow returns the current time. Overridden in tests.
+	getDeprecationNow = time.Now
+)
+
+func checkDeprecationsNotExpired(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	for _, descriptor := range descriptors {
+		maxAge := descriptor.ProtoSet.Config.Lint.DeprecationMaxAge
+		if maxAge == 0 {
+			continue
+		}
+		var lineTimes map[int]time.Time
+		var lineTimesErr error
+		check := func(v baseAddVisitor, deprecation *deprecation) {
+			// only run git blame for files with deprecated elements
+			if lineTimes == nil && lineTimesErr == nil {
+				lineTimes, lineTimesErr = getDeprecationLineTimes(descriptor.Path)
+			}
+			// files that are not tracked in git cannot be checked
+			if lineTimesErr != nil {
+				return
+			}
+			deprecatedTime, ok := lineTimes[deprecation.option.Position.Line]
+			if !ok {
+				return
+			}
+			if age := getDeprecationNow().Sub(deprecatedTime); age > maxAge {
+				v.AddFailuref(deprecation.position, "%s %q has been deprecated since %s, longer than the maximum of %s, and should be deleted.", deprecation.kind, deprecation.name, deprecatedTime.Format("2006-01-02"), formatDeprecationMaxAge(maxAge))
+			}
+		}
+		if err := runVisitor(newDeprecationVisitor(add, check), []*FileDescriptor{descriptor}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatDeprecationMaxAge(maxAge time.Duration) string {
+	if maxAge%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%d days", maxAge/(24*time.Hour))
+	}
+	return maxAge.String()
+}
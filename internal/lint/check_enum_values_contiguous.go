@@ -0,0 +1,69 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var enumValuesContiguousLinter = NewLinter(
+	"ENUM_VALUES_CONTIGUOUS",
+	`Verifies that the values of each enum are contiguous from zero, except for reserved values.`,
+	checkEnumValuesContiguous,
+)
+
+func checkEnumValuesContiguous(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(enumValuesContiguousVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type enumValuesContiguousVisitor struct {
+	baseAddVisitor
+}
+
+func (v enumValuesContiguousVisitor) VisitMessage(message *proto.Message) {
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v enumValuesContiguousVisitor) VisitEnum(enum *proto.Enum) {
+	var ranges []proto.Range
+	max := 0
+	for _, child := range enum.Elements {
+		switch element := child.(type) {
+		case *proto.EnumField:
+			if element.Integer < 0 {
+				v.AddFailuref(element.Position, "Enum value %q is negative, enum values should be contiguous from zero.", element.Name)
+				continue
+			}
+			ranges = append(ranges, proto.Range{From: element.Integer, To: element.Integer})
+			if element.Integer > max {
+				max = element.Integer
+			}
+		case *proto.Reserved:
+			ranges = append(ranges, element.Ranges...)
+		}
+	}
+	if gaps := getNumberGaps(ranges, 0, max); len(gaps) > 0 {
+		v.AddFailuref(enum.Position, "Enum %q has no values or reserved ranges for the numbers %s, enum values should be contiguous from zero.", enum.Name, getRangesString(gaps))
+	}
+}
@@ -22,21 +22,23 @@ package lint
 
 import (
 	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
 	"github.com/uber/prototool/internal/text"
 )
 
-var enumsNoAllowAliasLinter = NewLinter(
+var enumsNoAllowAliasLinter = NewSuppressableLinter(
 	"ENUMS_NO_ALLOW_ALIAS",
 	`Verifies that no enums use the option "allow_alias".`,
+	"allow-alias",
 	checkEnumsNoAllowAlias,
 )
 
-func checkEnumsNoAllowAlias(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
-	return runVisitor(enumsNoAllowAliasVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+func checkEnumsNoAllowAlias(add func(*file.ProtoSet, *proto.Comment, *text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(enumsNoAllowAliasVisitor{baseAddSuppressableVisitor: newBaseAddSuppressableVisitor(add)}, descriptors)
 }
 
 type enumsNoAllowAliasVisitor struct {
-	baseAddVisitor
+	*baseAddSuppressableVisitor
 }
 
 func (v enumsNoAllowAliasVisitor) VisitMessage(element *proto.Message) {
@@ -57,10 +59,10 @@ func (v enumsNoAllowAliasVisitor) VisitOption(element *proto.Option) {
 	if element.Parent == nil {
 		return
 	}
-	switch element.Parent.(type) {
+	switch parent := element.Parent.(type) {
 	case (*proto.Enum):
 		if element.Name == "allow_alias" {
-			v.AddFailuref(element.Position, "Enum aliases are not allowed.")
+			v.AddFailuref(parent.Comment, element.Position, "Enum aliases are not allowed.")
 		}
 	}
 }
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/text"
+)
+
+var extensionsAllowedLinter = NewSuppressableLinter(
+	"EXTENSIONS_ALLOWED",
+	`Verifies that only the messages in lint.extensions.allow declare extension ranges or are extended.`,
+	"extensions",
+	checkExtensionsAllowed,
+)
+
+func checkExtensionsAllowed(add func(*file.ProtoSet, *proto.Comment, *text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&extensionsAllowedVisitor{baseAddSuppressableVisitor: newBaseAddSuppressableVisitor(add)}, descriptors)
+}
+
+type extensionsAllowedVisitor struct {
+	*baseAddSuppressableVisitor
+	allowedMessages map[string]struct{}
+	scope           []string
+}
+
+func (v *extensionsAllowedVisitor) OnStart(descriptor *FileDescriptor) error {
+	if err := v.baseAddSuppressableVisitor.OnStart(descriptor); err != nil {
+		return err
+	}
+	allowedMessages := descriptor.ProtoSet.Config.Lint.ExtensionsAllowedMessages
+	v.allowedMessages = make(map[string]struct{}, len(allowedMessages))
+	for _, allowedMessage := range allowedMessages {
+		v.allowedMessages[allowedMessage] = struct{}{}
+	}
+	v.scope = getPackageScope(descriptor)
+	return nil
+}
+
+func (v *extensionsAllowedVisitor) VisitMessage(message *proto.Message) {
+	if message.IsExtend {
+		if !v.isAllowedExtendee(message.Name) {
+			v.AddFailuref(message.Comment, message.Position, `Message %q is extended but is not in lint.extensions.allow.`, message.Name)
+		}
+		return
+	}
+	v.scope = append(v.scope, message.Name)
+	for _, element := range message.Elements {
+		element.Accept(v)
+	}
+	v.scope = v.scope[:len(v.scope)-1]
+}
+
+func (v *extensionsAllowedVisitor) VisitExtensions(extensions *proto.Extensions) {
+	fullName := strings.Join(v.scope, ".")
+	if _, ok := v.allowedMessages[fullName]; !ok {
+		v.AddFailuref(extensions.Comment, extensions.Position, `Message %q declares extension ranges but is not in lint.extensions.allow.`, fullName)
+	}
+}
+
+// isAllowedExtendee returns true if the type name may refer to an allowed
+// message, searching from the innermost scope outwards.
+func (v *extensionsAllowedVisitor) isAllowedExtendee(typeName string) bool {
+	if strings.HasPrefix(typeName, ".") {
+		_, ok := v.allowedMessages[strings.TrimPrefix(typeName, ".")]
+		return ok
+	}
+	for i := len(v.scope); i >= 0; i-- {
+		if _, ok := v.allowedMessages[joinFullName(strings.Join(v.scope[:i], "."), typeName)]; ok {
+			return true
+		}
+	}
+	return false
+}
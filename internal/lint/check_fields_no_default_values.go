@@ -0,0 +1,76 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/text"
+)
+
+var fieldsNoDefaultValuesLinter = NewSuppressableLinter(
+	"FIELDS_NO_DEFAULT_VALUES",
+	`Verifies that no fields set the option "default".`,
+	"default-values",
+	checkFieldsNoDefaultValues,
+)
+
+func checkFieldsNoDefaultValues(add func(*file.ProtoSet, *proto.Comment, *text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&fieldsNoDefaultValuesVisitor{baseAddSuppressableVisitor: newBaseAddSuppressableVisitor(add)}, descriptors)
+}
+
+type fieldsNoDefaultValuesVisitor struct {
+	*baseAddSuppressableVisitor
+}
+
+func (v *fieldsNoDefaultValuesVisitor) VisitMessage(message *proto.Message) {
+	for _, element := range message.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNoDefaultValuesVisitor) VisitOneof(oneof *proto.Oneof) {
+	for _, element := range oneof.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNoDefaultValuesVisitor) VisitGroup(group *proto.Group) {
+	for _, element := range group.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNoDefaultValuesVisitor) VisitNormalField(field *proto.NormalField) {
+	v.checkField(field.Field)
+}
+
+func (v *fieldsNoDefaultValuesVisitor) VisitOneofField(field *proto.OneOfField) {
+	v.checkField(field.Field)
+}
+
+func (v *fieldsNoDefaultValuesVisitor) checkField(field *proto.Field) {
+	for _, option := range field.Options {
+		if option.Name == "default" {
+			v.AddFailuref(field.Comment, option.Position, `Field %q has a default value, default values are not supported in proto3 and are easily missed by readers of the field.`, field.Name)
+		}
+	}
+}
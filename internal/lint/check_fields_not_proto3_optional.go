@@ -0,0 +1,93 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/text"
+)
+
+var fieldsNotProto3OptionalLinter = NewSuppressableLinter(
+	"FIELDS_NOT_PROTO3_OPTIONAL",
+	`Verifies that no fields in proto3 files are optional, except for the fields of the messages in lint.proto3_optional.allow.`,
+	"proto3-optional",
+	checkFieldsNotProto3Optional,
+)
+
+func checkFieldsNotProto3Optional(add func(*file.ProtoSet, *proto.Comment, *text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&fieldsNotProto3OptionalVisitor{baseAddSuppressableVisitor: newBaseAddSuppressableVisitor(add)}, descriptors)
+}
+
+type fieldsNotProto3OptionalVisitor struct {
+	*baseAddSuppressableVisitor
+	allowedMessages map[string]struct{}
+	proto3          bool
+	scope           []string
+}
+
+func (v *fieldsNotProto3OptionalVisitor) OnStart(descriptor *FileDescriptor) error {
+	if err := v.baseAddSuppressableVisitor.OnStart(descriptor); err != nil {
+		return err
+	}
+	allowedMessages := descriptor.ProtoSet.Config.Lint.Proto3OptionalAllowedMessages
+	v.allowedMessages = make(map[string]struct{}, len(allowedMessages))
+	for _, allowedMessage := range allowedMessages {
+		v.allowedMessages[allowedMessage] = struct{}{}
+	}
+	v.proto3 = getSyntax(descriptor) == "proto3"
+	v.scope = getPackageScope(descriptor)
+	return nil
+}
+
+func (v *fieldsNotProto3OptionalVisitor) VisitMessage(message *proto.Message) {
+	if !v.proto3 || message.IsExtend {
+		return
+	}
+	v.scope = append(v.scope, message.Name)
+	for _, element := range message.Elements {
+		element.Accept(v)
+	}
+	v.scope = v.scope[:len(v.scope)-1]
+}
+
+func (v *fieldsNotProto3OptionalVisitor) VisitNormalField(field *proto.NormalField) {
+	if !field.Optional {
+		return
+	}
+	fullName := strings.Join(v.scope, ".")
+	if _, ok := v.allowedMessages[fullName]; !ok {
+		v.AddFailuref(field.Comment, field.Position, `Field %q of message %q is optional, optional fields in proto3 are only allowed in the messages in lint.proto3_optional.allow.`, field.Name, fullName)
+	}
+}
+
+// getSyntax returns the syntax of the file, which is "proto2" if
+// there is no syntax declaration.
+func getSyntax(descriptor *FileDescriptor) string {
+	for _, element := range descriptor.Elements {
+		if syntax, ok := element.(*proto.Syntax); ok {
+			return syntax.Value
+		}
+	}
+	return "proto2"
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/text"
+)
+
+var fieldsNotRequiredLinter = NewSuppressableLinter(
+	"FIELDS_NOT_REQUIRED",
+	`Verifies that no fields or groups are required.`,
+	"required",
+	checkFieldsNotRequired,
+)
+
+func checkFieldsNotRequired(add func(*file.ProtoSet, *proto.Comment, *text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&fieldsNotRequiredVisitor{baseAddSuppressableVisitor: newBaseAddSuppressableVisitor(add)}, descriptors)
+}
+
+type fieldsNotRequiredVisitor struct {
+	*baseAddSuppressableVisitor
+}
+
+func (v *fieldsNotRequiredVisitor) VisitMessage(message *proto.Message) {
+	for _, element := range message.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNotRequiredVisitor) VisitOneof(oneof *proto.Oneof) {
+	for _, element := range oneof.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNotRequiredVisitor) VisitGroup(group *proto.Group) {
+	if group.Required {
+		v.AddFailuref(group.Comment, group.Position, `Group %q is required, required fields can never be removed without breaking wire compatibility.`, group.Name)
+	}
+	for _, element := range group.Elements {
+		element.Accept(v)
+	}
+}
+
+func (v *fieldsNotRequiredVisitor) VisitNormalField(field *proto.NormalField) {
+	if field.Required {
+		v.AddFailuref(field.Comment, field.Position, `Field %q is required, required fields can never be removed without breaking wire compatibility.`, field.Name)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var fileMaxImportsLinter = NewLinter(
+	"FILE_MAX_IMPORTS",
+	`Verifies that no file has more imports than lint.limits.max_file_imports if set in the configuration file.`,
+	checkFileMaxImports,
+)
+
+func checkFileMaxImports(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&fileMaxImportsVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type fileMaxImportsVisitor struct {
+	baseAddVisitor
+	maxImports int
+	numImports int
+}
+
+func (v *fileMaxImportsVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.maxImports = descriptor.ProtoSet.Config.Lint.Limits.MaxFileImports
+	v.numImports = 0
+	return nil
+}
+
+func (v *fileMaxImportsVisitor) VisitImport(element *proto.Import) {
+	if v.maxImports == 0 {
+		return
+	}
+	v.numImports++
+	// only fail once per file, on the first import over the maximum
+	if v.numImports == v.maxImports+1 {
+		v.AddFailuref(element.Position, "File has more imports than the maximum of %d.", v.maxImports)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/settings"
+	"github.com/uber/prototool/internal/strs"
+	"github.com/uber/prototool/internal/text"
+)
+
+var importsAllowedLinter = NewLinter(
+	"IMPORTS_ALLOWED",
+	`Verifies that all imports match an allowed pattern of the lint.imports rules for the directory if set in the configuration file.`,
+	checkImportsAllowed,
+)
+
+func checkImportsAllowed(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&importsAllowedVisitor{baseAddVisitor: newBaseAddVisitor(add), dirPath: dirPath}, descriptors)
+}
+
+type importsAllowedVisitor struct {
+	baseAddVisitor
+	dirPath     string
+	importRules []settings.LintImportRule
+}
+
+func (v *importsAllowedVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.importRules = getLintImportRules(descriptor.ProtoSet.Config, v.dirPath)
+	return nil
+}
+
+func (v *importsAllowedVisitor) VisitImport(element *proto.Import) {
+	for _, importRule := range v.importRules {
+		if len(importRule.AllowPatterns) > 0 && matchLintImportPattern(importRule.AllowPatterns, element.Filename) == "" {
+			v.AddFailuref(element.Position, "Import %q is not allowed by the lint.imports rule for %q, allowed imports are %s.", element.Filename, importRule.DirPattern, strings.Join(importRule.AllowPatterns, ", "))
+		}
+	}
+}
+
+// getLintImportRules returns the lint import rules of the config that
+// apply to the files in the directory.
+func getLintImportRules(config settings.Config, dirPath string) []settings.LintImportRule {
+	if len(config.Lint.ImportRules) == 0 {
+		return nil
+	}
+	relDirPath, err := filepath.Rel(config.DirPath, dirPath)
+	if err != nil {
+		return nil
+	}
+	relDirPath = filepath.ToSlash(relDirPath)
+	if relDirPath == ".." || strings.HasPrefix(relDirPath, "../") {
+		return nil
+	}
+	var importRules []settings.LintImportRule
+	for _, importRule := range config.Lint.ImportRules {
+		// patterns are validated when the config is parsed
+		if matched, _ := strs.MatchSlashGlob(importRule.DirPattern, relDirPath); matched {
+			importRules = append(importRules, importRule)
+		}
+	}
+	return importRules
+}
+
+// matchLintImportPattern returns the first of the patterns that matches
+// the import path, or "" if none match.
+func matchLintImportPattern(patterns []string, importPath string) string {
+	for _, pattern := range patterns {
+		if matched, _ := strs.MatchSlashGlob(pattern, importPath); matched {
+			return pattern
+		}
+	}
+	return ""
+}
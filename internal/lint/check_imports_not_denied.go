@@ -0,0 +1,56 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/settings"
+	"github.com/uber/prototool/internal/text"
+)
+
+var importsNotDeniedLinter = NewLinter(
+	"IMPORTS_NOT_DENIED",
+	`Verifies that no import matches a denied pattern of the lint.imports rules for the directory if set in the configuration file.`,
+	checkImportsNotDenied,
+)
+
+func checkImportsNotDenied(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&importsNotDeniedVisitor{baseAddVisitor: newBaseAddVisitor(add), dirPath: dirPath}, descriptors)
+}
+
+type importsNotDeniedVisitor struct {
+	baseAddVisitor
+	dirPath     string
+	importRules []settings.LintImportRule
+}
+
+func (v *importsNotDeniedVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.importRules = getLintImportRules(descriptor.ProtoSet.Config, v.dirPath)
+	return nil
+}
+
+func (v *importsNotDeniedVisitor) VisitImport(element *proto.Import) {
+	for _, importRule := range v.importRules {
+		if pattern := matchLintImportPattern(importRule.DenyPatterns, element.Filename); pattern != "" {
+			v.AddFailuref(element.Position, "Import %q matches the denied pattern %q of the lint.imports rule for %q.", element.Filename, pattern, importRule.DirPattern)
+		}
+	}
+}
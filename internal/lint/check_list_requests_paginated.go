@@ -0,0 +1,47 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/uber/prototool/internal/text"
+)
+
+var listRequestsPaginatedLinter = NewLinter(
+	"LIST_REQUESTS_PAGINATED",
+	`Verifies that the request types of List standard methods have an "int32 page_size" and a "string page_token" field.`,
+	checkListRequestsPaginated,
+)
+
+func checkListRequestsPaginated(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runStandardMethodVisitor(add, checkListRequestsPaginatedMethod, descriptors)
+}
+
+func checkListRequestsPaginatedMethod(v baseAddVisitor, method *standardMethod) {
+	if method.verb != "List" || method.request == nil {
+		return
+	}
+	if field := getStandardMethodField(method.request, "page_size"); field == nil || field.Type != "int32" {
+		v.AddFailuref(method.request.Position, `Request type %q of List method %q should have an "int32 page_size" field.`, method.request.Name, method.rpc.Name)
+	}
+	if field := getStandardMethodField(method.request, "page_token"); field == nil || field.Type != "string" {
+		v.AddFailuref(method.request.Position, `Request type %q of List method %q should have a "string page_token" field.`, method.request.Name, method.rpc.Name)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/uber/prototool/internal/text"
+)
+
+var listResponsesNextPageTokenLinter = NewLinter(
+	"LIST_RESPONSES_NEXT_PAGE_TOKEN",
+	`Verifies that the response types of List standard methods have a "string next_page_token" field.`,
+	checkListResponsesNextPageToken,
+)
+
+func checkListResponsesNextPageToken(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runStandardMethodVisitor(add, checkListResponsesNextPageTokenMethod, descriptors)
+}
+
+func checkListResponsesNextPageTokenMethod(v baseAddVisitor, method *standardMethod) {
+	if method.verb != "List" || method.response == nil {
+		return
+	}
+	if field := getStandardMethodField(method.response, "next_page_token"); field == nil || field.Type != "string" {
+		v.AddFailuref(method.response.Position, `Response type %q of List method %q should have a "string next_page_token" field.`, method.response.Name, method.rpc.Name)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"sort"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+const (
+	// the field numbers reserved for the Protocol Buffers implementation
+	implementationReservedFromNumber = 19000
+	implementationReservedToNumber   = 19999
+)
+
+var messageFieldNumbersNoGapsLinter = NewLinter(
+	"MESSAGE_FIELD_NUMBERS_NO_GAPS",
+	`Verifies that all field numbers from 1 to the highest field number of each message are used by a field, or are reserved.`,
+	checkMessageFieldNumbersNoGaps,
+)
+
+func checkMessageFieldNumbersNoGaps(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(messageFieldNumbersNoGapsVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type messageFieldNumbersNoGapsVisitor struct {
+	baseAddVisitor
+}
+
+func (v messageFieldNumbersNoGapsVisitor) VisitMessage(message *proto.Message) {
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	if message.IsExtend {
+		return
+	}
+	fields := getNumberedFields(message)
+	if len(fields) == 0 {
+		return
+	}
+	ranges := []proto.Range{{From: implementationReservedFromNumber, To: implementationReservedToNumber}}
+	max := 0
+	for _, field := range fields {
+		ranges = append(ranges, proto.Range{From: field.number, To: field.number})
+		if field.number > max {
+			max = field.number
+		}
+	}
+	for _, child := range message.Elements {
+		switch element := child.(type) {
+		case *proto.Reserved:
+			ranges = append(ranges, element.Ranges...)
+		case *proto.Extensions:
+			ranges = append(ranges, element.Ranges...)
+		}
+	}
+	if gaps := getNumberGaps(ranges, 1, max); len(gaps) > 0 {
+		v.AddFailuref(message.Position, "Message %q has no fields or reserved ranges for the field numbers %s.", message.Name, getRangesString(gaps))
+	}
+}
+
+// numberedField is a field of a message with its number.
+type numberedField struct {
+	position scanner.Position
+	name     string
+	number   int
+}
+
+// getNumberedFields returns the fields of the message, including fields
+// in oneofs and groups, in declaration order.
+func getNumberedFields(message *proto.Message) []*numberedField {
+	var fields []*numberedField
+	for _, child := range message.Elements {
+		switch element := child.(type) {
+		case *proto.NormalField:
+			fields = append(fields, &numberedField{position: element.Position, name: element.Name, number: element.Sequence})
+		case *proto.MapField:
+			fields = append(fields, &numberedField{position: element.Position, name: element.Name, number: element.Sequence})
+		case *proto.Group:
+			fields = append(fields, &numberedField{position: element.Position, name: element.Name, number: element.Sequence})
+		case *proto.Oneof:
+			for _, oneofChild := range element.Elements {
+				switch oneofElement := oneofChild.(type) {
+				case *proto.OneOfField:
+					fields = append(fields, &numberedField{position: oneofElement.Position, name: oneofElement.Name, number: oneofElement.Sequence})
+				case *proto.Group:
+					fields = append(fields, &numberedField{position: oneofElement.Position, name: oneofElement.Name, number: oneofElement.Sequence})
+				}
+			}
+		}
+	}
+	return fields
+}
+
+// getNumberGaps returns the ranges of numbers between from and to,
+// inclusive, that are not covered by any of the given ranges.
+func getNumberGaps(ranges []proto.Range, from int, to int) []proto.Range {
+	ranges = append([]proto.Range(nil), ranges...)
+	sort.Slice(ranges, func(i int, j int) bool { return ranges[i].From < ranges[j].From })
+	var gaps []proto.Range
+	next := from
+	for _, r := range ranges {
+		if next > to {
+			break
+		}
+		if r.From > next {
+			gaps = append(gaps, proto.Range{From: next, To: minInt(r.From-1, to)})
+		}
+		if r.Max {
+			return gaps
+		}
+		if r.To+1 > next {
+			next = r.To + 1
+		}
+	}
+	if next <= to {
+		gaps = append(gaps, proto.Range{From: next, To: to})
+	}
+	return gaps
+}
+
+func getRangesString(ranges []proto.Range) string {
+	rangeStrings := make([]string, len(ranges))
+	for i, r := range ranges {
+		rangeStrings[i] = r.SourceRepresentation()
+	}
+	return strings.Join(rangeStrings, ", ")
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var messageFieldNumbersNotImplementationReservedLinter = NewLinter(
+	"MESSAGE_FIELD_NUMBERS_NOT_IMPLEMENTATION_RESERVED",
+	`Verifies that no field number is in the range 19000 to 19999, which is reserved for the Protocol Buffers implementation.`,
+	checkMessageFieldNumbersNotImplementationReserved,
+)
+
+func checkMessageFieldNumbersNotImplementationReserved(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(messageFieldNumbersNotImplementationReservedVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type messageFieldNumbersNotImplementationReservedVisitor struct {
+	baseAddVisitor
+}
+
+func (v messageFieldNumbersNotImplementationReservedVisitor) VisitMessage(message *proto.Message) {
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	for _, field := range getNumberedFields(message) {
+		if field.number >= implementationReservedFromNumber && field.number <= implementationReservedToNumber {
+			v.AddFailuref(field.position, "Field %q has number %d, but the field numbers %d to %d are reserved for the Protocol Buffers implementation.", field.name, field.number, implementationReservedFromNumber, implementationReservedToNumber)
+		}
+	}
+}
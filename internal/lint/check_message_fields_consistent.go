@@ -0,0 +1,251 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var messageFieldsConsistentLinter = NewProtoSetLinter(
+	"MESSAGE_FIELDS_CONSISTENT",
+	`Verifies that all fields with the same name have the same label and type across all packages, and the type configured in lint.canonical_fields if set.`,
+	checkMessageFieldsConsistent,
+)
+
+// scalarTypes are the scalar field types, which are never resolved
+// to messages or enums.
+var scalarTypes = map[string]struct{}{
+	"double":   {},
+	"float":    {},
+	"int32":    {},
+	"int64":    {},
+	"uint32":   {},
+	"uint64":   {},
+	"sint32":   {},
+	"sint64":   {},
+	"fixed32":  {},
+	"fixed64":  {},
+	"sfixed32": {},
+	"sfixed64": {},
+	"bool":     {},
+	"string":   {},
+	"bytes":    {},
+}
+
+func checkMessageFieldsConsistent(add func(*text.Failure), dirPathToDescriptors map[string][]*FileDescriptor) error {
+	dirPaths := make([]string, 0, len(dirPathToDescriptors))
+	for dirPath := range dirPathToDescriptors {
+		dirPaths = append(dirPaths, dirPath)
+	}
+	sort.Strings(dirPaths)
+	typesVisitor := &consistentTypesVisitor{typeFullNames: make(map[string]struct{})}
+	for _, dirPath := range dirPaths {
+		if err := runVisitor(typesVisitor, dirPathToDescriptors[dirPath]); err != nil {
+			return err
+		}
+	}
+	fieldsVisitor := &consistentFieldsVisitor{
+		typeFullNames: typesVisitor.typeFullNames,
+		nameToFields:  make(map[string][]*consistentField),
+	}
+	var canonicalFieldNameToType map[string]string
+	for _, dirPath := range dirPaths {
+		descriptors := dirPathToDescriptors[dirPath]
+		if len(descriptors) > 0 {
+			canonicalFieldNameToType = descriptors[0].ProtoSet.Config.Lint.CanonicalFieldNameToType
+		}
+		if err := runVisitor(fieldsVisitor, descriptors); err != nil {
+			return err
+		}
+	}
+
+	names := make([]string, 0, len(fieldsVisitor.nameToFields))
+	for name := range fieldsVisitor.nameToFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fields := fieldsVisitor.nameToFields[name]
+		if canonicalType, ok := canonicalFieldNameToType[name]; ok {
+			canonicalSignature := getCanonicalFieldSignature(canonicalType)
+			for _, field := range fields {
+				if field.signature != canonicalSignature {
+					add(text.NewFailuref(field.position, "", "Field %q has type %q but the canonical type for fields named %q is %q.", name, field.signature, name, canonicalSignature))
+				}
+			}
+			continue
+		}
+		commonField := getMostCommonConsistentField(fields)
+		for _, field := range fields {
+			if field.signature != commonField.signature {
+				add(text.NewFailuref(field.position, "", "Field %q has type %q but other fields named %q have type %q, for example at %s:%d:%d.", name, field.signature, name, commonField.signature, commonField.position.Filename, commonField.position.Line, commonField.position.Column))
+			}
+		}
+	}
+	return nil
+}
+
+// consistentField is a field with its label and resolved type, for
+// example "repeated foo.v1.Bar".
+type consistentField struct {
+	position  scanner.Position
+	signature string
+}
+
+// getMostCommonConsistentField returns the first field with the most
+// common signature.
+func getMostCommonConsistentField(fields []*consistentField) *consistentField {
+	signatureToCount := make(map[string]int)
+	for _, field := range fields {
+		signatureToCount[field.signature]++
+	}
+	var commonField *consistentField
+	for _, field := range fields {
+		if commonField == nil || signatureToCount[field.signature] > signatureToCount[commonField.signature] {
+			commonField = field
+		}
+	}
+	return commonField
+}
+
+// getCanonicalFieldSignature returns the signature for the type
+// configured in lint.canonical_fields.
+func getCanonicalFieldSignature(canonicalType string) string {
+	for _, label := range []string{"repeated ", "optional ", "required "} {
+		if strings.HasPrefix(canonicalType, label) {
+			return label + strings.TrimPrefix(strings.TrimPrefix(canonicalType, label), ".")
+		}
+	}
+	return strings.TrimPrefix(canonicalType, ".")
+}
+
+// consistentTypesVisitor collects the full names of all messages and enums.
+type consistentTypesVisitor struct {
+	baseVisitor
+	typeFullNames map[string]struct{}
+	scope         []string
+}
+
+func (v *consistentTypesVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.scope = getPackageScope(descriptor)
+	return nil
+}
+
+func (v *consistentTypesVisitor) VisitMessage(message *proto.Message) {
+	if message.IsExtend {
+		return
+	}
+	v.typeFullNames[joinFullName(strings.Join(v.scope, "."), message.Name)] = struct{}{}
+	v.scope = append(v.scope, message.Name)
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	v.scope = v.scope[:len(v.scope)-1]
+}
+
+func (v *consistentTypesVisitor) VisitEnum(enum *proto.Enum) {
+	v.typeFullNames[joinFullName(strings.Join(v.scope, "."), enum.Name)] = struct{}{}
+}
+
+// consistentFieldsVisitor collects the fields of all messages by name.
+type consistentFieldsVisitor struct {
+	baseVisitor
+	typeFullNames map[string]struct{}
+	nameToFields  map[string][]*consistentField
+	scope         []string
+}
+
+func (v *consistentFieldsVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.scope = getPackageScope(descriptor)
+	return nil
+}
+
+func (v *consistentFieldsVisitor) VisitMessage(message *proto.Message) {
+	if message.IsExtend {
+		return
+	}
+	v.scope = append(v.scope, message.Name)
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	v.scope = v.scope[:len(v.scope)-1]
+}
+
+func (v *consistentFieldsVisitor) VisitOneof(oneof *proto.Oneof) {
+	for _, child := range oneof.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v *consistentFieldsVisitor) VisitNormalField(field *proto.NormalField) {
+	signature := v.resolveType(field.Type)
+	switch {
+	case field.Repeated:
+		signature = "repeated " + signature
+	case field.Optional:
+		signature = "optional " + signature
+	case field.Required:
+		signature = "required " + signature
+	}
+	v.addField(field.Field, signature)
+}
+
+func (v *consistentFieldsVisitor) VisitOneofField(field *proto.OneOfField) {
+	v.addField(field.Field, v.resolveType(field.Type))
+}
+
+func (v *consistentFieldsVisitor) VisitMapField(field *proto.MapField) {
+	v.addField(field.Field, fmt.Sprintf("map<%s, %s>", field.KeyType, v.resolveType(field.Type)))
+}
+
+func (v *consistentFieldsVisitor) addField(field *proto.Field, signature string) {
+	v.nameToFields[field.Name] = append(v.nameToFields[field.Name], &consistentField{
+		position:  field.Position,
+		signature: signature,
+	})
+}
+
+// resolveType returns the full name of the message or enum that the type
+// name refers to, searching from the innermost scope outwards.
+//
+// Scalar types and types that are not in the ProtoSet, such as imported
+// types, are returned as written without a leading ".".
+func (v *consistentFieldsVisitor) resolveType(typeName string) string {
+	if _, ok := scalarTypes[typeName]; ok {
+		return typeName
+	}
+	if strings.HasPrefix(typeName, ".") {
+		return strings.TrimPrefix(typeName, ".")
+	}
+	for i := len(v.scope); i >= 0; i-- {
+		fullName := joinFullName(strings.Join(v.scope[:i], "."), typeName)
+		if _, ok := v.typeFullNames[fullName]; ok {
+			return fullName
+		}
+	}
+	return typeName
+}
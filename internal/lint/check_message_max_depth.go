@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var messageMaxDepthLinter = NewLinter(
+	"MESSAGE_MAX_DEPTH",
+	`Verifies that no message is nested deeper than lint.limits.max_message_depth if set in the configuration file, where top-level messages have a depth of 1.`,
+	checkMessageMaxDepth,
+)
+
+func checkMessageMaxDepth(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&messageMaxDepthVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type messageMaxDepthVisitor struct {
+	baseAddVisitor
+	maxDepth int
+	depth    int
+}
+
+func (v *messageMaxDepthVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.maxDepth = descriptor.ProtoSet.Config.Lint.Limits.MaxMessageDepth
+	v.depth = 0
+	return nil
+}
+
+func (v *messageMaxDepthVisitor) VisitMessage(message *proto.Message) {
+	if v.maxDepth == 0 {
+		return
+	}
+	v.depth++
+	if v.depth > v.maxDepth {
+		v.AddFailuref(message.Position, "Message %q has a depth of %d but the maximum is %d.", message.Name, v.depth, v.maxDepth)
+	} else {
+		// only the outermost message that is too deep fails
+		for _, child := range message.Elements {
+			child.Accept(v)
+		}
+	}
+	v.depth--
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var messageMaxFieldsLinter = NewLinter(
+	"MESSAGE_MAX_FIELDS",
+	`Verifies that no message has more fields than lint.limits.max_message_fields if set in the configuration file.`,
+	checkMessageMaxFields,
+)
+
+func checkMessageMaxFields(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&messageMaxFieldsVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type messageMaxFieldsVisitor struct {
+	baseAddVisitor
+	maxFields int
+}
+
+func (v *messageMaxFieldsVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.maxFields = descriptor.ProtoSet.Config.Lint.Limits.MaxMessageFields
+	return nil
+}
+
+func (v *messageMaxFieldsVisitor) VisitMessage(message *proto.Message) {
+	if v.maxFields == 0 {
+		return
+	}
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	if numFields := len(getNumberedFields(message)); numFields > v.maxFields {
+		v.AddFailuref(message.Position, "Message %q has %d fields but the maximum is %d.", message.Name, numFields, v.maxFields)
+	}
+}
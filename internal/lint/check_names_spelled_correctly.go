@@ -0,0 +1,66 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+
+	"github.com/uber/prototool/internal/strs"
+	"github.com/uber/prototool/internal/text"
+)
+
+var namesSpelledCorrectlyLinter = NewLinter(
+	"NAMES_SPELLED_CORRECTLY",
+	`Verifies that the words of the names of all messages, fields, oneofs, enums, enum values, services and RPCs are spelled correctly, using the bundled English wordlist and the dictionary in lint.dictionary.path.`,
+	checkNamesSpelledCorrectly,
+)
+
+func checkNamesSpelledCorrectly(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	checker := getSpellChecker(descriptors)
+	return runVisitor(newNamedElementVisitor(add, func(v baseAddVisitor, element *namedElement) {
+		for _, word := range getNameWords(element.name) {
+			if !checker.IsCorrect(word) {
+				v.AddFailuref(element.position, "Name of %s %q has the misspelled word %q.", element.kind, element.name, word)
+			}
+		}
+	}), descriptors)
+}
+
+// getNameWords returns the words of a CamelCase, lower_snake_case or
+// UPPER_SNAKE_CASE name.
+func getNameWords(name string) []string {
+	var words []string
+	for _, part := range strings.Split(name, "_") {
+		if part == "" {
+			continue
+		}
+		if strs.IsLowercase(part) || strs.IsUppercase(part) {
+			words = append(words, part)
+			continue
+		}
+		if camelCaseWords := strs.SplitCamelCaseWord(part); len(camelCaseWords) > 0 {
+			words = append(words, camelCaseWords...)
+			continue
+		}
+		words = append(words, part)
+	}
+	return words
+}
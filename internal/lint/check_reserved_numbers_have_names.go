@@ -0,0 +1,73 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var reservedNumbersHaveNamesLinter = NewLinter(
+	"RESERVED_NUMBERS_HAVE_NAMES",
+	`Verifies that every message and enum that reserves individual numbers also reserves at least as many names.`,
+	checkReservedNumbersHaveNames,
+)
+
+func checkReservedNumbersHaveNames(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(reservedNumbersHaveNamesVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type reservedNumbersHaveNamesVisitor struct {
+	baseAddVisitor
+}
+
+func (v reservedNumbersHaveNamesVisitor) VisitMessage(message *proto.Message) {
+	for _, child := range message.Elements {
+		child.Accept(v)
+	}
+	v.checkReserved("Message", message.Position, message.Name, message.Elements)
+}
+
+func (v reservedNumbersHaveNamesVisitor) VisitEnum(enum *proto.Enum) {
+	v.checkReserved("Enum", enum.Position, enum.Name, enum.Elements)
+}
+
+func (v reservedNumbersHaveNamesVisitor) checkReserved(kind string, position scanner.Position, name string, elements []proto.Visitee) {
+	// ranges of numbers are usually reserved up front, while individual
+	// numbers are reserved when a field or value is removed
+	numNumbers := 0
+	numNames := 0
+	for _, element := range elements {
+		if reserved, ok := element.(*proto.Reserved); ok {
+			for _, r := range reserved.Ranges {
+				if !r.Max && r.From == r.To {
+					numNumbers++
+				}
+			}
+			numNames += len(reserved.FieldNames)
+		}
+	}
+	if numNames < numNumbers {
+		v.AddFailuref(position, "%s %q reserves %d individual numbers but only %d names, the names of removed fields and values should be reserved along with their numbers.", kind, name, numNumbers, numNames)
+	}
+}
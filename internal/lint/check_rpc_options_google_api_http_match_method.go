@@ -0,0 +1,133 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var rpcOptionsGoogleAPIHTTPMatchMethodLinter = NewLinter(
+	"RPC_OPTIONS_GOOGLE_API_HTTP_MATCH_METHOD",
+	`Verifies that the "google.api.http" option of each standard method uses the HTTP verb, path and body of the standard method.`,
+	checkRPCOptionsGoogleAPIHTTPMatchMethod,
+)
+
+// standardMethodVerbToHTTPVerb is the map from standard method verb to the
+// HTTP verb of the "google.api.http" option.
+var standardMethodVerbToHTTPVerb = map[string]string{
+	"Get":    "get",
+	"List":   "get",
+	"Create": "post",
+	"Update": "patch",
+	"Delete": "delete",
+}
+
+func checkRPCOptionsGoogleAPIHTTPMatchMethod(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runStandardMethodVisitor(add, checkRPCOptionsGoogleAPIHTTPMatchMethodMethod, descriptors)
+}
+
+func checkRPCOptionsGoogleAPIHTTPMatchMethodMethod(v baseAddVisitor, method *standardMethod) {
+	rule := getGoogleAPIHTTPRule(method.rpc)
+	if rule == nil {
+		return
+	}
+	expectedVerb := standardMethodVerbToHTTPVerb[method.verb]
+	path, ok := rule.verbToPath[expectedVerb]
+	if !ok {
+		v.AddFailuref(rule.position, `Option "google.api.http" of standard method %q should use the HTTP verb %q.`, method.rpc.Name, expectedVerb)
+		return
+	}
+	// Get, Update and Delete act on a single resource named by the last path
+	// segment, while List and Create act on a collection
+	endsWithVariable := strings.HasSuffix(path, "}")
+	switch method.verb {
+	case "Get", "Update", "Delete":
+		if !endsWithVariable {
+			v.AddFailuref(rule.position, `Option "google.api.http" of standard method %q should have a path that ends with the resource name variable, but was %q.`, method.rpc.Name, path)
+		}
+	default:
+		if endsWithVariable {
+			v.AddFailuref(rule.position, `Option "google.api.http" of standard method %q should have a path that ends with the collection, but was %q.`, method.rpc.Name, path)
+		}
+	}
+	switch method.verb {
+	case "Create", "Update":
+		if rule.body == "" || rule.body == "*" {
+			v.AddFailuref(rule.position, `Option "google.api.http" of standard method %q should set the body to the resource field.`, method.rpc.Name)
+		}
+	default:
+		if rule.body != "" {
+			v.AddFailuref(rule.position, `Option "google.api.http" of standard method %q should not have a body.`, method.rpc.Name)
+		}
+	}
+}
+
+type googleAPIHTTPRule struct {
+	position   scanner.Position
+	verbToPath map[string]string
+	body       string
+}
+
+// getGoogleAPIHTTPRule returns the "google.api.http" rule of the RPC,
+// or nil if the RPC has no such option.
+//
+// Both the aggregate form and the "(google.api.http).get" form are supported.
+func getGoogleAPIHTTPRule(rpc *proto.RPC) *googleAPIHTTPRule {
+	var rule *googleAPIHTTPRule
+	for _, child := range rpc.Elements {
+		option, ok := child.(*proto.Option)
+		if !ok {
+			continue
+		}
+		name := strings.TrimPrefix(option.Name, "(.")
+		name = strings.TrimPrefix(name, "(")
+		if !strings.HasPrefix(name, "google.api.http)") {
+			continue
+		}
+		if rule == nil {
+			rule = &googleAPIHTTPRule{
+				position:   option.Position,
+				verbToPath: make(map[string]string),
+			}
+		}
+		if key := strings.TrimPrefix(strings.TrimPrefix(name, "google.api.http)"), "."); key != "" {
+			addGoogleAPIHTTPRuleValue(rule, key, option.Constant.Source)
+			continue
+		}
+		for _, namedLiteral := range option.Constant.OrderedMap {
+			addGoogleAPIHTTPRuleValue(rule, namedLiteral.Name, namedLiteral.Source)
+		}
+	}
+	return rule
+}
+
+func addGoogleAPIHTTPRuleValue(rule *googleAPIHTTPRule, key string, value string) {
+	switch key {
+	case "get", "put", "post", "delete", "patch":
+		rule.verbToPath[key] = value
+	case "body":
+		rule.body = value
+	}
+}
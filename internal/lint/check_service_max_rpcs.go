@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var serviceMaxRPCsLinter = NewLinter(
+	"SERVICE_MAX_RPCS",
+	`Verifies that no service has more RPCs than lint.limits.max_service_rpcs if set in the configuration file.`,
+	checkServiceMaxRPCs,
+)
+
+func checkServiceMaxRPCs(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runVisitor(&serviceMaxRPCsVisitor{baseAddVisitor: newBaseAddVisitor(add)}, descriptors)
+}
+
+type serviceMaxRPCsVisitor struct {
+	baseAddVisitor
+	maxRPCs int
+}
+
+func (v *serviceMaxRPCsVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.maxRPCs = descriptor.ProtoSet.Config.Lint.Limits.MaxServiceRPCs
+	return nil
+}
+
+func (v *serviceMaxRPCsVisitor) VisitService(service *proto.Service) {
+	if v.maxRPCs == 0 {
+		return
+	}
+	numRPCs := 0
+	for _, child := range service.Elements {
+		if _, ok := child.(*proto.RPC); ok {
+			numRPCs++
+		}
+	}
+	if numRPCs > v.maxRPCs {
+		v.AddFailuref(service.Position, "Service %q has %d RPCs but the maximum is %d.", service.Name, numRPCs, v.maxRPCs)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/text"
+)
+
+var standardMethodTypesLinter = NewLinter(
+	"STANDARD_METHOD_TYPES",
+	`Verifies that the standard methods Get, List, Create, Update and Delete use the standard request and response types.`,
+	checkStandardMethodTypes,
+)
+
+func checkStandardMethodTypes(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runStandardMethodVisitor(add, checkStandardMethodTypesMethod, descriptors)
+}
+
+func checkStandardMethodTypesMethod(v baseAddVisitor, method *standardMethod) {
+	rpc := method.rpc
+	if expected := rpc.Name + "Request"; getLastTypeNameComponent(rpc.RequestType) != expected {
+		v.AddFailuref(rpc.Position, "Request type of standard method %q should be %q but was %q.", rpc.Name, expected, rpc.RequestType)
+	}
+	responseType := getLastTypeNameComponent(rpc.ReturnsType)
+	switch method.verb {
+	case "List":
+		if expected := rpc.Name + "Response"; responseType != expected {
+			v.AddFailuref(rpc.Position, "Response type of standard method %q should be %q but was %q.", rpc.Name, expected, rpc.ReturnsType)
+		}
+	case "Delete":
+		if !isWKTType(rpc.ReturnsType, "Empty") && responseType != method.resource {
+			v.AddFailuref(rpc.Position, "Response type of standard method %q should be %q or %q but was %q.", rpc.Name, "google.protobuf.Empty", method.resource, rpc.ReturnsType)
+		}
+	default:
+		if responseType != method.resource {
+			v.AddFailuref(rpc.Position, "Response type of standard method %q should be the resource %q but was %q.", rpc.Name, method.resource, rpc.ReturnsType)
+		}
+	}
+}
+
+// standardMethodVerbs are the verbs that prefix the names of the standard
+// methods of resource-oriented APIs, see https://aip.dev/130.
+var standardMethodVerbs = []string{
+	"Get",
+	"List",
+	"Create",
+	"Update",
+	"Delete",
+}
+
+// standardMethod is an RPC that is a standard method.
+//
+// request and response are only set if the corresponding message is a
+// top-level message in a file in the same directory as the RPC.
+type standardMethod struct {
+	rpc      *proto.RPC
+	verb     string
+	resource string
+	request  *proto.Message
+	response *proto.Message
+}
+
+// getStandardMethodVerbAndResource returns the verb and resource of the
+// RPC name, for example "Get" and "Book" for "GetBook".
+func getStandardMethodVerbAndResource(rpcName string) (string, string, bool) {
+	for _, verb := range standardMethodVerbs {
+		resource := strings.TrimPrefix(rpcName, verb)
+		if resource != rpcName && resource != "" && unicode.IsUpper([]rune(resource)[0]) {
+			return verb, resource, true
+		}
+	}
+	return "", "", false
+}
+
+// runStandardMethodVisitor calls check for each standard method in the
+// files.
+//
+// The top-level messages of all files are collected first, so that the
+// request and response types of a standard method are found even if they
+// are defined in another file of the directory.
+func runStandardMethodVisitor(add func(*text.Failure), check func(baseAddVisitor, *standardMethod), descriptors []*FileDescriptor) error {
+	messagesVisitor := &standardMethodMessagesVisitor{
+		messages: make(map[string]*proto.Message),
+	}
+	if err := runVisitor(messagesVisitor, descriptors); err != nil {
+		return err
+	}
+	return runVisitor(&standardMethodVisitor{
+		baseAddVisitor: newBaseAddVisitor(add),
+		check:          check,
+		messages:       messagesVisitor.messages,
+	}, descriptors)
+}
+
+// standardMethodMessagesVisitor collects the top-level messages of all
+// files by full name.
+type standardMethodMessagesVisitor struct {
+	baseVisitor
+	pkg      string
+	messages map[string]*proto.Message
+}
+
+func (v *standardMethodMessagesVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.pkg = strings.Join(getPackageScope(descriptor), ".")
+	return nil
+}
+
+func (v *standardMethodMessagesVisitor) VisitMessage(message *proto.Message) {
+	if !message.IsExtend {
+		v.messages[joinFullName(v.pkg, message.Name)] = message
+	}
+}
+
+// standardMethodVisitor calls check for each standard method in a file
+// once the file has been visited.
+type standardMethodVisitor struct {
+	baseAddVisitor
+	check    func(baseAddVisitor, *standardMethod)
+	scope    []string
+	messages map[string]*proto.Message
+	methods  []*standardMethod
+}
+
+func (v *standardMethodVisitor) OnStart(descriptor *FileDescriptor) error {
+	v.scope = getPackageScope(descriptor)
+	v.methods = nil
+	return nil
+}
+
+func (v *standardMethodVisitor) VisitService(service *proto.Service) {
+	for _, child := range service.Elements {
+		child.Accept(v)
+	}
+}
+
+func (v *standardMethodVisitor) VisitRPC(rpc *proto.RPC) {
+	if verb, resource, ok := getStandardMethodVerbAndResource(rpc.Name); ok {
+		v.methods = append(v.methods, &standardMethod{
+			rpc:      rpc,
+			verb:     verb,
+			resource: resource,
+		})
+	}
+}
+
+func (v *standardMethodVisitor) Finally() error {
+	for _, method := range v.methods {
+		method.request = v.getMessage(method.rpc.RequestType)
+		method.response = v.getMessage(method.rpc.ReturnsType)
+		v.check(v.baseAddVisitor, method)
+	}
+	return nil
+}
+
+// getMessage returns the top-level message that the type name refers to,
+// or nil if the message is not defined in the directory.
+//
+// Relative type names are searched for from the package of the file
+// outwards, for example "foo.v1.Book" and then "foo.Book" for "Book" in
+// package foo.v1.
+func (v *standardMethodVisitor) getMessage(typeName string) *proto.Message {
+	if strings.HasPrefix(typeName, ".") {
+		return v.messages[strings.TrimPrefix(typeName, ".")]
+	}
+	for i := len(v.scope); i >= 0; i-- {
+		if message, ok := v.messages[joinFullName(strings.Join(v.scope[:i], "."), typeName)]; ok {
+			return message
+		}
+	}
+	return nil
+}
+
+// getStandardMethodField returns the non-repeated field with the given
+// name in the message, or nil if there is no such field.
+func getStandardMethodField(message *proto.Message, name string) *proto.NormalField {
+	for _, child := range message.Elements {
+		if field, ok := child.(*proto.NormalField); ok && field.Name == name && !field.Repeated {
+			return field
+		}
+	}
+	return nil
+}
+
+// getLastTypeNameComponent returns the last component of a possibly
+// qualified type name, for example "Book" for "foo.v1.Book".
+func getLastTypeNameComponent(typeName string) string {
+	return typeName[strings.LastIndexByte(typeName, '.')+1:]
+}
+
+// isWKTType returns true if the type name refers to the given
+// Well-Known Type, for example "Empty" for "google.protobuf.Empty".
+func isWKTType(typeName string, name string) bool {
+	return strings.TrimPrefix(typeName, ".") == "google.protobuf."+name
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"github.com/uber/prototool/internal/text"
+)
+
+var updateRequestsUpdateMaskLinter = NewLinter(
+	"UPDATE_REQUESTS_UPDATE_MASK",
+	`Verifies that the request types of Update standard methods have a "google.protobuf.FieldMask update_mask" field.`,
+	checkUpdateRequestsUpdateMask,
+)
+
+func checkUpdateRequestsUpdateMask(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runStandardMethodVisitor(add, checkUpdateRequestsUpdateMaskMethod, descriptors)
+}
+
+func checkUpdateRequestsUpdateMaskMethod(v baseAddVisitor, method *standardMethod) {
+	if method.verb != "Update" || method.request == nil {
+		return
+	}
+	if field := getStandardMethodField(method.request, "update_mask"); field == nil || !isWKTType(field.Type, "FieldMask") {
+		v.AddFailuref(method.request.Position, `Request type %q of Update method %q should have a "google.protobuf.FieldMask update_mask" field.`, method.request.Name, method.rpc.Name)
+	}
+}
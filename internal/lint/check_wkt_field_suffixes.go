@@ -0,0 +1,60 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/uber/prototool/internal/location"
+	"github.com/uber/prototool/internal/text"
+)
+
+var wktFieldSuffixesLinter = NewDescriptorLinter(
+	"WKT_FIELD_SUFFIXES",
+	`Verifies that all fields whose type resolves to google.protobuf.Timestamp are named "time" or end in "_time", and all fields whose type resolves to google.protobuf.Duration are named "duration" or end in "_duration".`,
+	checkWKTFieldSuffixes,
+)
+
+// wktTypeNameToFieldSuffix is the map from the fully-qualified type name
+// of a well-known type to the suffix of the fields of that type.
+var wktTypeNameToFieldSuffix = map[string]string{
+	".google.protobuf.Duration":  "duration",
+	".google.protobuf.Timestamp": "time",
+}
+
+func checkWKTFieldSuffixes(add func(*text.Failure), dirPath string, descriptors []*FileDescriptor) error {
+	return runDescriptorVisitor(wktFieldSuffixesVisitor{baseAddDescriptorVisitor: newBaseAddDescriptorVisitor(add)}, descriptors)
+}
+
+type wktFieldSuffixesVisitor struct {
+	*baseAddDescriptorVisitor
+}
+
+func (v wktFieldSuffixesVisitor) VisitField(path location.Path, field *descriptor.FieldDescriptorProto) {
+	suffix, ok := wktTypeNameToFieldSuffix[field.GetTypeName()]
+	if !ok {
+		return
+	}
+	if field.GetName() != suffix && !strings.HasSuffix(field.GetName(), "_"+suffix) {
+		v.AddFailuref(path, `Field %q of type %q must be named %q or end in "_%s".`, field.GetName(), strings.TrimPrefix(field.GetTypeName(), "."), suffix, suffix)
+	}
+}
@@ -0,0 +1,2639 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+// _idToDoc contains the documentation for each linter, keyed by linter ID.
+//
+// Every linter in AllLinters must have an entry. The examples are checked
+// in docs_test.go: each bad example must produce at least one failure for
+// its linter, and each good example must produce none. The examples for
+// the limit, import and deprecation linters assume the configuration in
+// docs_test.go.
+var _idToDoc = map[string]*Doc{
+	"COMMENTS_NO_C_STYLE": {
+		Rationale: `C-style /* */ comments are inconsistently handled by documentation generators and formatters. Line comments are the norm in Protobuf.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+/* Foo is a foo. */
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+message Foo {}
+`,
+	},
+	"COMMENTS_NO_INLINE": {
+		Rationale: `Inline comments are not attached as leading comments to the element they describe, and are frequently lost by generators.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1; // The ID.
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  // The ID.
+  int64 id = 1;
+}
+`,
+	},
+	"COMMENTS_NO_TODOS": {
+		Rationale: `Comments on declarations are the public documentation of an API, and are published by documentation generators. TODO and FIXME markers belong in an issue tracker, not in the documentation that clients read.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+//
+// TODO: add the bar field.
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+message Foo {}
+`,
+	},
+	"COMMENTS_NOT_RESTATING_NAMES": {
+		Rationale: `A comment that only restates the name of a declaration adds no information. The comment should explain what the declaration is for, how it is used, or how it relates to other declarations.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// The user profile.
+message UserProfile {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// UserProfile is the public information about a user that other users can see.
+message UserProfile {}
+`,
+	},
+	"COMMENTS_SPELLED_CORRECTLY": {
+		Rationale: `Comments are the public documentation of an API. Misspelled words make the documentation harder to read and search. Words that are specific to a project can be added to the dictionary file in lint.dictionary.path.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is the mesage that is recieved from the server.
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is the message that is received from the server.
+message Foo {}
+`,
+	},
+	"DEPRECATED_MESSAGES_NOT_REFERENCED": {
+		Rationale: `A field or RPC that uses a deprecated message keeps the deprecated message in use. Either the field or RPC should be deprecated as well, or it should use the replacement message.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Deprecated: Use Bar instead.
+message Foo {
+  option deprecated = true;
+}
+
+message Baz {
+  Foo foo = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Deprecated: Use Bar instead.
+message Foo {
+  option deprecated = true;
+}
+
+message Baz {
+  // Deprecated: Use bar instead.
+  Foo foo = 1 [deprecated = true];
+  Bar bar = 2;
+}
+`,
+	},
+	"DEPRECATIONS_HAVE_COMMENTS": {
+		Rationale: `The deprecated option only marks an element as deprecated in generated code. Users of a deprecated element need to know what to use instead.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1 [deprecated = true];
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  // Deprecated: Use uuid instead.
+  int64 id = 1 [deprecated = true];
+  string uuid = 2;
+}
+`,
+	},
+	"DEPRECATIONS_NOT_EXPIRED": {
+		Rationale: `Deprecated elements that are never deleted accumulate and make APIs harder to understand. Once all users have migrated, deprecated elements should be deleted and their numbers and names reserved. The examples assume a max_age of 90 days and that the files have not been changed since 2018.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  // Deprecated: Use uuid instead.
+  int64 id = 1 [deprecated = true];
+  string uuid = 2;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  reserved 1;
+  reserved "id";
+  string uuid = 2;
+}
+`,
+	},
+	"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE": {
+		Rationale: `UPPER_SNAKE_CASE enum value names are the convention of the Protobuf style guide, and map cleanly to constants in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOOBar = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_BAR = 1;
+}
+`,
+	},
+	"ENUM_FIELD_NAMES_UPPERCASE": {
+		Rationale: `Uppercase enum value names are the convention of the Protobuf style guide, and map cleanly to constants in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_bar = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_BAR = 1;
+}
+`,
+	},
+	"ENUM_FIELD_PREFIXES": {
+		Rationale: `Enum values use C++ scoping rules, so values of sibling enums in the same package conflict unless they are prefixed. Including the names of enclosing messages ensures uniqueness for nested enums as well.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {
+  enum Foo {
+    FOO_INVALID = 0;
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {
+  enum Foo {
+    BAR_FOO_INVALID = 0;
+  }
+}
+`,
+	},
+	"ENUM_FIELD_PREFIXES_EXCEPT_MESSAGE": {
+		Rationale: `Enum values use C++ scoping rules, so values of sibling enums in the same package conflict unless they are prefixed with the enum name.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  INVALID = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+	},
+	"ENUM_NAMES_CAMEL_CASE": {
+		Rationale: `CamelCase enum names are the convention of the Protobuf style guide, and map cleanly to type names in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo_Bar {
+  FOO_BAR_INVALID = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum FooBar {
+  FOO_BAR_INVALID = 0;
+}
+`,
+	},
+	"ENUM_NAMES_CAPITALIZED": {
+		Rationale: `Capitalized enum names are the convention of the Protobuf style guide, and are required for the generated types to be exported in languages such as Go.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum foo {
+  FOO_INVALID = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+	},
+	"ENUM_VALUES_CONTIGUOUS": {
+		Rationale: `Gaps in enum values usually indicate removed values that were not reserved, which risks the numbers being reused with a different meaning.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_ONE = 1;
+  FOO_THREE = 3;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  reserved 2;
+  reserved "FOO_TWO";
+  FOO_INVALID = 0;
+  FOO_ONE = 1;
+  FOO_THREE = 3;
+}
+`,
+	},
+	"ENUM_ZERO_VALUES_INVALID": {
+		Rationale: `The zero value is the default for unset enum fields, so it should not carry any meaning. Naming it *_INVALID makes it clear that it should never be set explicitly.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {
+  enum Foo {
+    BAR_FOO_ACTIVE = 0;
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Bar {
+  enum Foo {
+    BAR_FOO_INVALID = 0;
+    BAR_FOO_ACTIVE = 1;
+  }
+}
+`,
+	},
+	"ENUM_ZERO_VALUES_INVALID_EXCEPT_MESSAGE": {
+		Rationale: `The zero value is the default for unset enum fields, so it should not carry any meaning. Naming it *_INVALID makes it clear that it should never be set explicitly.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_ACTIVE = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_ACTIVE = 1;
+}
+`,
+	},
+	"ENUMS_HAVE_COMMENTS": {
+		Rationale: `Enums are part of the public API, and comments are carried into the generated code and documentation.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+	},
+	"ENUMS_HAVE_SENTENCE_COMMENTS": {
+		Rationale: `Enums are part of the public API, and comments are carried into the generated code and documentation. Complete sentences read well in every rendering.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// a foo
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// A foo.
+enum Foo {
+  FOO_INVALID = 0;
+}
+`,
+	},
+	"ENUMS_NO_ALLOW_ALIAS": {
+		Rationale: `Aliased enum values make the JSON and text representations ambiguous, and make it unclear which name should be used.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  option allow_alias = true;
+  FOO_INVALID = 0;
+  FOO_ONE = 1;
+  FOO_UNO = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+enum Foo {
+  FOO_INVALID = 0;
+  FOO_ONE = 1;
+}
+`,
+	},
+	"EXTENSIONS_ALLOWED": {
+		Rationale: `Extensions are a proto2 feature that proto3 only supports for custom options. Each extendable message is a place where unrelated packages can add fields, so extensions should be limited to the messages that are meant to be extended.`,
+		BadExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  optional int64 id = 1;
+  extensions 100 to 199;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/descriptor.proto";
+
+extend google.protobuf.FieldOptions {
+  string foo_label = 50000;
+}
+`,
+	},
+	"FIELDS_NO_DEFAULT_VALUES": {
+		Rationale: `Default values are a proto2 feature that proto3 does not support. A field with a default value reads differently depending on whether it was set, which readers of the message easily miss.`,
+		BadExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  optional int64 page_size = 1 [default = 10];
+}
+`,
+		GoodExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  optional int64 page_size = 1;
+}
+`,
+	},
+	"FIELDS_NOT_PROTO3_OPTIONAL": {
+		Rationale: `Optional fields in proto3 add field presence, which is not supported by all versions of protoc and the generated code, and which is rarely needed outside of messages that distinguish unset values from zero values.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  optional int64 id = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1;
+}
+
+message FooPatch {
+  optional int64 id = 1;
+}
+`,
+	},
+	"FIELDS_NOT_REQUIRED": {
+		Rationale: `Required fields can never be removed or made optional without breaking readers of older messages, and proto3 does not support them.`,
+		BadExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  required int64 id = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  optional int64 id = 1;
+}
+`,
+	},
+	"FIELDS_NOT_RESERVED": {
+		Rationale: `Reserved fields and values accumulate over time and obscure the shape of the message. Deprecating fields instead keeps them visible to readers.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  reserved 2;
+  int64 id = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1;
+  string name = 2 [deprecated = true];
+}
+`,
+	},
+	"FILE_HEADER": {
+		Rationale: `A consistent file header, such as a license, is often required by policy, and is tedious to verify by hand. The header is configured with lint.file_header.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+// Copyright (c) 2019 Example, Inc.
+
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"FILE_MAX_IMPORTS": {
+		Rationale: `A file with many imports usually mixes several concerns, which makes it slow to compile and hard to understand. The examples assume max_file_imports is 2.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+import "foo/v1/baz.proto";
+import "foo/v1/bat.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+import "foo/v1/baz.proto";
+`,
+	},
+	"FILE_MAX_LINES": {
+		Rationale: `Long files are hard to review and navigate. Large APIs should be split across several files in the same package. The examples assume max_file_lines is 10.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+  int64 two = 2;
+  int64 three = 3;
+  int64 four = 4;
+  int64 five = 5;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+}
+`,
+	},
+	"FILE_NAMES_LOWER_SNAKE_CASE": {
+		Rationale: `lower_snake_case file names are the convention of the Protobuf style guide, and work well with generators that derive output file names from them.`,
+		BadExample: `
+// File: FooBar.proto
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+// File: foo_bar.proto
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"FILE_OPTIONS_EQUAL_CSHARP_NAMESPACE_CAPITALIZED": {
+		Rationale: `Deriving csharp_namespace from the package keeps generated C# namespaces consistent and predictable across all files.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo.V1";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_GO_PACKAGE_PB_SUFFIX": {
+		Rationale: `Deriving go_package from the last package component with a pb suffix keeps generated Go package names consistent and avoids conflicts with hand-written packages.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foo";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "v1pb";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_GO_PACKAGE_V2_SUFFIX": {
+		Rationale: `Deriving go_package from the last two package components keeps generated Go package names consistent and unique across major versions.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "v1pb";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_JAVA_MULTIPLE_FILES_TRUE": {
+		Rationale: `Generating one Java file per top-level type avoids very large outer classes and keeps generated type names short.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = false;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+`,
+	},
+	"FILE_OPTIONS_EQUAL_JAVA_OUTER_CLASSNAME_PROTO_SUFFIX": {
+		Rationale: `Deriving java_outer_classname from the file name with a Proto suffix avoids conflicts between the outer class and the types it contains.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_outer_classname = "Example";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_outer_classname = "ExampleProto";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_JAVA_PACKAGE_COM_PREFIX": {
+		Rationale: `Deriving java_package from the package keeps generated Java packages consistent and follows the reverse domain naming convention.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "foo.v1";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.foo.v1";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_OBJC_CLASS_PREFIX_ABBR": {
+		Rationale: `Objective-C has no namespaces, so class prefixes are needed to avoid conflicts. Deriving the prefix from the package keeps it consistent.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FOO";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FXX";
+`,
+	},
+	"FILE_OPTIONS_EQUAL_PHP_NAMESPACE_CAPITALIZED": {
+		Rationale: `Deriving php_namespace from the package keeps generated PHP namespaces consistent and predictable across all files.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo\\V1";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_CSHARP_NAMESPACE": {
+		Rationale: `Setting csharp_namespace explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo.V1";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_GO_PACKAGE": {
+		Rationale: `Setting go_package explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_JAVA_MULTIPLE_FILES": {
+		Rationale: `Setting java_multiple_files explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_JAVA_OUTER_CLASSNAME": {
+		Rationale: `Setting java_outer_classname explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_outer_classname = "ExampleProto";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_JAVA_PACKAGE": {
+		Rationale: `Setting java_package explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.foo.v1";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_OBJC_CLASS_PREFIX": {
+		Rationale: `Setting objc_class_prefix explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FXX";
+`,
+	},
+	"FILE_OPTIONS_REQUIRE_PHP_NAMESPACE": {
+		Rationale: `Setting php_namespace explicitly makes the generated code for each language independent of the defaults of the code generator.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo\\V1";
+`,
+	},
+	"FILE_OPTIONS_CSHARP_NAMESPACE_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so csharp_namespace should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo.V1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Bar.V1";
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo.V1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option csharp_namespace = "Foo.V1";
+`,
+	},
+	"FILE_OPTIONS_GO_PACKAGE_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so go_package should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "barv1";
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+`,
+	},
+	"FILE_OPTIONS_JAVA_MULTIPLE_FILES_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so java_multiple_files should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = false;
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+`,
+	},
+	"FILE_OPTIONS_JAVA_PACKAGE_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so java_package should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.foo.v1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.bar.v1";
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.foo.v1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option java_package = "com.foo.v1";
+`,
+	},
+	"FILE_OPTIONS_OBJC_CLASS_PREFIX_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so objc_class_prefix should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FXX";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "BXX";
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FXX";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option objc_class_prefix = "FXX";
+`,
+	},
+	"FILE_OPTIONS_PHP_NAMESPACE_SAME_IN_DIR": {
+		Rationale: `All files in a directory are expected to belong to the same package, so php_namespace should be the same for all of them, otherwise the generated code for one package is split across several.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo\\V1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Bar\\V1";
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo\\V1";
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+option php_namespace = "Foo\\V1";
+`,
+	},
+	"FILE_OPTIONS_UNSET_JAVA_MULTIPLE_FILES": {
+		Rationale: `Some setups manage java_multiple_files outside of the Protobuf files, for example in the build system, so the option should not be set in the files themselves.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_multiple_files = true;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"FILE_OPTIONS_UNSET_JAVA_OUTER_CLASSNAME": {
+		Rationale: `Some setups manage java_outer_classname outside of the Protobuf files, for example in the build system, so the option should not be set in the files themselves.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option java_outer_classname = "ExampleProto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"FILE_OPTIONS_GO_PACKAGE_NOT_LONG_FORM": {
+		Rationale: `The import path in the long form of go_package ties the Protobuf files to a single repository layout. The import path is better supplied by the build system.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "github.com/example/foo/v1;foov1";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+option go_package = "foov1";
+`,
+	},
+	"GOGO_NOT_IMPORTED": {
+		Rationale: `gogo/protobuf extensions tie the Protobuf files to a single code generator, and make them harder to use from other languages and plugins.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "gogo/protobuf/gogoproto/gogo.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"IMPORTS_ALLOWED": {
+		Rationale: `Restricting the imports of a directory to known dependencies keeps the dependency graph of the Protobuf files intentional. The examples assume a lint.imports rule for all directories that allows "foo/**" and "google/protobuf/*.proto".`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "bar/v1/bar.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+import "google/protobuf/timestamp.proto";
+`,
+	},
+	"IMPORTS_NOT_DENIED": {
+		Rationale: `Some files should not be depended on from other directories, for example internal Protobuf files should not be imported by public APIs. The examples assume a lint.imports rule for all directories that denies "foo/internal/**".`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/internal/v1/bar.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/bar.proto";
+`,
+	},
+	"IMPORTS_NOT_PUBLIC": {
+		Rationale: `Public imports are not supported by every code generator, and make it unclear where a type is actually defined.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import public "bar/v1/bar.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "bar/v1/bar.proto";
+`,
+	},
+	"IMPORTS_NOT_WEAK": {
+		Rationale: `Weak imports are not supported by most code generators, and their semantics are not well defined.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import weak "bar/v1/bar.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "bar/v1/bar.proto";
+`,
+	},
+	"LIST_REQUESTS_PAGINATED": {
+		Rationale: `List methods that are not paginated cannot add pagination later without breaking clients that expect all results in one response. See https://aip.dev/158.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc ListFoos(ListFoosRequest) returns (ListFoosResponse);
+}
+
+message ListFoosRequest {
+  string parent = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc ListFoos(ListFoosRequest) returns (ListFoosResponse);
+}
+
+message ListFoosRequest {
+  string parent = 1;
+  int32 page_size = 2;
+  string page_token = 3;
+}
+`,
+	},
+	"LIST_RESPONSES_NEXT_PAGE_TOKEN": {
+		Rationale: `Clients of paginated List methods need a token to request the next page of results. See https://aip.dev/158.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc ListFoos(ListFoosRequest) returns (ListFoosResponse);
+}
+
+message ListFoosResponse {
+  repeated Foo foos = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc ListFoos(ListFoosRequest) returns (ListFoosResponse);
+}
+
+message ListFoosResponse {
+  repeated Foo foos = 1;
+  string next_page_token = 2;
+}
+`,
+	},
+	"MESSAGE_FIELD_NAMES_FILENAME": {
+		Rationale: `Consistent naming makes APIs easier to understand. Use "filename" rather than "file_name", as the former is the common spelling in most languages and standard libraries.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string file_name = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string filename = 1;
+}
+`,
+	},
+	"MESSAGE_FIELD_NAMES_FILEPATH": {
+		Rationale: `Consistent naming makes APIs easier to understand. Use "filepath" rather than "file_path", as the former is the common spelling in most languages and standard libraries.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string file_path = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string filepath = 1;
+}
+`,
+	},
+	"MESSAGE_FIELD_NAMES_LOWER_SNAKE_CASE": {
+		Rationale: `lower_snake_case field names are the convention of the Protobuf style guide, and are converted to the idiomatic casing of each generated language, as well as to lowerCamelCase for JSON.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string fooBar = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string foo_bar = 1;
+}
+`,
+	},
+	"MESSAGE_FIELD_NAMES_LOWERCASE": {
+		Rationale: `Lowercase field names are the convention of the Protobuf style guide, and are converted to the idiomatic casing of each generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string Foo = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string foo = 1;
+}
+`,
+	},
+	"MESSAGE_FIELD_NUMBERS_NO_GAPS": {
+		Rationale: `Gaps in field numbers usually indicate removed fields that were not reserved, which risks the numbers being reused with a different type or meaning.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+  int64 three = 3;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  reserved 2;
+  reserved "two";
+  int64 one = 1;
+  int64 three = 3;
+}
+`,
+	},
+	"MESSAGE_FIELD_NUMBERS_NOT_IMPLEMENTATION_RESERVED": {
+		Rationale: `The field numbers 19000 to 19999 are reserved for the Protocol Buffers implementation, and protoc rejects them.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 19000;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1;
+}
+`,
+	},
+	"MESSAGE_FIELDS_CONSISTENT": {
+		Rationale: `Fields with the same name are expected to mean the same thing. A field named user_id that is a string in one package and an int64 in another confuses readers and makes data harder to join across APIs.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string user_id = 1;
+}
+
+message Bar {
+  int64 user_id = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string user_id = 1;
+}
+
+message Bar {
+  string user_id = 1;
+}
+`,
+	},
+	"MESSAGE_FIELDS_DURATION": {
+		Rationale: `Durations represented as integers leave the unit ambiguous. google.protobuf.Duration is unambiguous and has first class support in every language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 timeout_duration = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/duration.proto";
+
+message Foo {
+  google.protobuf.Duration timeout_duration = 1;
+}
+`,
+	},
+	"MESSAGE_FIELDS_IN_NUMBER_ORDER": {
+		Rationale: `Declaring fields in the order of their numbers makes it easy to see which numbers are in use and which number to use next.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 two = 2;
+  int64 one = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+  int64 two = 2;
+}
+`,
+	},
+	"MESSAGE_FIELDS_NO_JSON_NAME": {
+		Rationale: `Custom JSON names make the JSON representation of a message diverge from the field names, which is confusing and not supported by every JSON implementation.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string foo_bar = 1 [json_name = "bar"];
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string foo_bar = 1;
+}
+`,
+	},
+	"MESSAGE_FIELDS_NOT_FLOATS": {
+		Rationale: `Single precision floats lose precision quickly and are rarely what is wanted. Use a double, or an int64 with an explicit unit such as micros.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  float price = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 price_micros = 1;
+}
+`,
+	},
+	"MESSAGE_FIELDS_TIME": {
+		Rationale: `Times represented as integers or strings leave the epoch, unit and format ambiguous. google.protobuf.Timestamp is unambiguous and has first class support in every language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 create_time = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Foo {
+  google.protobuf.Timestamp create_time = 1;
+}
+`,
+	},
+	"MESSAGE_MAX_DEPTH": {
+		Rationale: `Deeply nested messages have long generated names in most languages and are hard to reuse. The examples assume max_message_depth is 2.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  message Bar {
+    message Baz {}
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  message Bar {}
+}
+
+message Baz {}
+`,
+	},
+	"MESSAGE_MAX_FIELDS": {
+		Rationale: `Messages with many fields are hard to understand and usually combine several concepts that are better modeled as separate messages. The examples assume max_message_fields is 3.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+  int64 two = 2;
+  int64 three = 3;
+  int64 four = 4;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 one = 1;
+  int64 two = 2;
+  Bar bar = 3;
+}
+
+message Bar {
+  int64 three = 1;
+  int64 four = 2;
+}
+`,
+	},
+	"MESSAGE_NAMES_CAMEL_CASE": {
+		Rationale: `CamelCase message names are the convention of the Protobuf style guide, and map cleanly to type names in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo_Bar {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message FooBar {}
+`,
+	},
+	"MESSAGE_NAMES_CAPITALIZED": {
+		Rationale: `Capitalized message names are the convention of the Protobuf style guide, and are required for the generated types to be exported in languages such as Go.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+	},
+	"MESSAGES_HAVE_COMMENTS": {
+		Rationale: `Messages are part of the public API, and comments are carried into the generated code and documentation.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+message Foo {}
+`,
+	},
+	"MESSAGES_HAVE_COMMENTS_EXCEPT_REQUEST_RESPONSE_TYPES": {
+		Rationale: `Messages are part of the public API, and comments are carried into the generated code and documentation. Request and response types are documented by their RPC.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Foo is a foo.
+message Foo {}
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"MESSAGES_HAVE_SENTENCE_COMMENTS_EXCEPT_REQUEST_RESPONSE_TYPES": {
+		Rationale: `Messages are part of the public API, and comments are carried into the generated code and documentation. Complete sentences read well in every rendering. Request and response types are documented by their RPC.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// a foo
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// A foo.
+message Foo {}
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"MESSAGES_NO_GROUPS": {
+		Rationale: `Groups are deprecated and proto3 does not support them. A nested message has the same structure and is supported by all versions of Protobuf.`,
+		BadExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  optional group Bar = 1 {
+    optional int64 id = 2;
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto2";
+
+package foo.v1;
+
+message Foo {
+  message Bar {
+    optional int64 id = 1;
+  }
+  optional Bar bar = 1;
+}
+`,
+	},
+	"MESSAGES_NOT_EMPTY_EXCEPT_REQUEST_RESPONSE_TYPES": {
+		Rationale: `Empty messages usually indicate an unfinished or placeholder type. Request and response types may be empty, as fields can be added to them later without breaking the RPC.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  int64 id = 1;
+}
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"NAMES_NO_COMMON": {
+		Rationale: `"Common" does not describe what a type or field is, and tends to attract unrelated definitions over time.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message CommonFoo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+	},
+	"NAMES_NO_DATA": {
+		Rationale: `"Data" adds no information to a name, as every message is data.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message FooData {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+	},
+	"NAMES_NO_UUID": {
+		Rationale: `"UUID" describes the format of an identifier rather than what it identifies. Use "ID" instead.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string uuid = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string id = 1;
+}
+`,
+	},
+	"NAMES_SPELLED_CORRECTLY": {
+		Rationale: `Names are part of the API and appear in generated code in every language, and cannot be changed without breaking clients. Misspelled names are permanent, so they should be caught before they are released. Words that are specific to a project can be added to the dictionary file in lint.dictionary.path.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string adress = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  string address = 1;
+}
+`,
+	},
+	"ONEOF_NAMES_LOWER_SNAKE_CASE": {
+		Rationale: `lower_snake_case oneof names are the convention of the Protobuf style guide, and are converted to the idiomatic casing of each generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  oneof fooBar {
+    int64 id = 1;
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  oneof foo_bar {
+    int64 id = 1;
+  }
+}
+`,
+	},
+	"PACKAGE_IS_DECLARED": {
+		Rationale: `Types in files without a package are in the global namespace, where they easily conflict with types from other files.`,
+		BadExample: `
+syntax = "proto3";
+
+message Foo {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+`,
+	},
+	"PACKAGE_LOWER_CASE": {
+		Rationale: `Lowercase package names are the convention of the Protobuf style guide, and are used to derive package and namespace names in the generated code.`,
+		BadExample: `
+syntax = "proto3";
+
+package Foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"PACKAGE_LOWER_SNAKE_CASE": {
+		Rationale: `lower_snake_case package components are the convention of the Protobuf style guide, and are used to derive package and namespace names in the generated code.`,
+		BadExample: `
+syntax = "proto3";
+
+package fooBar.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo_bar.v1;
+`,
+	},
+	"PACKAGE_MAJOR_BETA_VERSIONED": {
+		Rationale: `A version suffix on the package allows a new major version of an API to be introduced alongside the previous one, without breaking existing consumers.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"PACKAGE_NO_KEYWORDS": {
+		Rationale: `Package components are used to derive package and namespace names in the generated code, and keywords such as "internal" or "public" are reserved in some languages.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.internal.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"PACKAGES_SAME_IN_DIR": {
+		Rationale: `A one-to-one mapping between directories and packages makes it easy to find the files for a package, and is required by the code generators for several languages.`,
+		BadExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package bar.v1;
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+`,
+		GoodExample: `
+// File: bar.proto
+syntax = "proto3";
+
+package foo.v1;
+
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"REQUEST_RESPONSE_NAMES_MATCH_RPC": {
+		Rationale: `Naming request and response types after their RPC makes it obvious which RPC a type belongs to, and allows each RPC to evolve independently.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(FooRequest) returns (FooResponse);
+}
+
+message FooRequest {}
+
+message FooResponse {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"REQUEST_RESPONSE_TYPES_AFTER_SERVICE": {
+		Rationale: `Declaring the request and response types after the service, in the order of the RPCs, lets readers see the API surface before its details.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"REQUEST_RESPONSE_TYPES_IN_SAME_FILE": {
+		Rationale: `Keeping the request and response types in the same file as their service makes the full definition of each RPC easy to find.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/foo.proto";
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+	},
+	"REQUEST_RESPONSE_TYPES_ONLY_IN_FILE": {
+		Rationale: `Files with services should only contain the services and their request and response types. Other types belong in separate files so that they can be shared without depending on the service.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {
+  Foo foo = 1;
+}
+
+message Foo {}
+`,
+		GoodExample: `
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {}
+
+// File: foo_api.proto
+syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/foo.proto";
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {
+  Foo foo = 1;
+}
+`,
+	},
+	"REQUEST_RESPONSE_TYPES_UNIQUE": {
+		Rationale: `Sharing request or response types between RPCs, including google.protobuf.Empty, prevents adding fields to one RPC without affecting the others.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+  rpc GetBar(GetFooRequest) returns (GetFooResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+  rpc GetBar(GetBarRequest) returns (GetBarResponse);
+}
+
+message GetFooRequest {}
+
+message GetFooResponse {}
+
+message GetBarRequest {}
+
+message GetBarResponse {}
+`,
+	},
+	"RESERVED_NUMBERS_HAVE_NAMES": {
+		Rationale: `Reserving the name of a removed field or value along with its number prevents the name from being reused, which would break JSON and text format compatibility.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  reserved 2;
+  int64 one = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+message Foo {
+  reserved 2;
+  reserved "two";
+  int64 one = 1;
+}
+`,
+	},
+	"RPC_NAMES_CAMEL_CASE": {
+		Rationale: `CamelCase RPC names are the convention of the Protobuf style guide, and map cleanly to method names in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc Get_Foo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+	},
+	"RPC_NAMES_CAPITALIZED": {
+		Rationale: `Capitalized RPC names are the convention of the Protobuf style guide, and are required for the generated methods to be exported in languages such as Go.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc getFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+	},
+	"RPC_OPTIONS_GOOGLE_API_HTTP_MATCH_METHOD": {
+		Rationale: `REST clients expect standard methods to map to the standard HTTP verbs and paths, for example a GET on the resource name for Get. See https://aip.dev/127.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/api/annotations.proto";
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (Foo) {
+    option (google.api.http) = {
+      post: "/v1/foos"
+      body: "*"
+    };
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/api/annotations.proto";
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (Foo) {
+    option (google.api.http) = {
+      get: "/v1/{name=foos/*}"
+    };
+  }
+}
+`,
+	},
+	"RPC_OPTIONS_NO_GOOGLE_API_HTTP": {
+		Rationale: `HTTP annotations couple the API definition to a specific gateway. The mapping can instead be configured outside of the Protobuf files.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/api/annotations.proto";
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse) {
+    option (google.api.http) = {
+      get: "/v1/foo"
+    };
+  }
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+	},
+	"RPCS_HAVE_COMMENTS": {
+		Rationale: `RPCs are part of the public API, and comments are carried into the generated code and documentation.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  // GetFoo gets a foo.
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+	},
+	"RPCS_HAVE_SENTENCE_COMMENTS": {
+		Rationale: `RPCs are part of the public API, and comments are carried into the generated code and documentation. Complete sentences read well in every rendering.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  // gets a foo
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  // Gets a foo.
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+	},
+	"SERVICE_MAX_RPCS": {
+		Rationale: `Services with many RPCs are hard to understand and to implement, and usually cover several resources that are better served by separate services. The examples assume max_service_rpcs is 2.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+  rpc GetBar(GetBarRequest) returns (GetBarResponse);
+  rpc GetBaz(GetBazRequest) returns (GetBazResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+
+service BarAPI {
+  rpc GetBar(GetBarRequest) returns (GetBarResponse);
+  rpc GetBaz(GetBazRequest) returns (GetBazResponse);
+}
+`,
+	},
+	"SERVICE_NAMES_API_SUFFIX": {
+		Rationale: `A consistent suffix makes services easy to recognize, and avoids conflicts with the messages they operate on.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooService {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+	},
+	"SERVICE_NAMES_CAMEL_CASE": {
+		Rationale: `CamelCase service names are the convention of the Protobuf style guide, and map cleanly to type names in every generated language.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service Foo_API {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+	},
+	"SERVICE_NAMES_CAPITALIZED": {
+		Rationale: `Capitalized service names are the convention of the Protobuf style guide, and are required for the generated types to be exported in languages such as Go.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service fooAPI {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+	},
+	"SERVICE_NAMES_MATCH_FILE_NAME": {
+		Rationale: `Naming the file after the service it contains makes the service easy to find.`,
+		BadExample: `
+// File: foo.proto
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+		GoodExample: `
+// File: foo_api.proto
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+	},
+	"SERVICE_NAMES_NO_PLURALS": {
+		Rationale: `Singular service names are consistent with the singular names used for the resources the service operates on.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FoosAPI {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+	},
+	"SERVICES_HAVE_COMMENTS": {
+		Rationale: `Services are part of the public API, and comments are carried into the generated code and documentation.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// FooAPI manages foos.
+service FooAPI {}
+`,
+	},
+	"SERVICES_HAVE_SENTENCE_COMMENTS": {
+		Rationale: `Services are part of the public API, and comments are carried into the generated code and documentation. Complete sentences read well in every rendering.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// manages foos
+service FooAPI {}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+// Manages foos.
+service FooAPI {}
+`,
+	},
+	"STANDARD_METHOD_TYPES": {
+		Rationale: `Consistent request and response types for standard methods make resource-oriented APIs predictable. Get, Create and Update return the resource, List returns a response with the resources, and Delete returns google.protobuf.Empty. See https://aip.dev/130.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (GetFooResponse);
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc GetFoo(GetFooRequest) returns (Foo);
+}
+`,
+	},
+	"SYNTAX_PROTO3": {
+		Rationale: `proto3 is simpler than proto2, has a canonical JSON mapping, and is supported by every code generator. Run prototool migrate proto3 to migrate proto2 files.`,
+		BadExample: `
+syntax = "proto2";
+
+package foo.v1;
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+`,
+	},
+	"UPDATE_REQUESTS_UPDATE_MASK": {
+		Rationale: `An update mask lets clients update only some fields of a resource, and lets fields be added to the resource without older clients clearing them. See https://aip.dev/134.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+service FooAPI {
+  rpc UpdateFoo(UpdateFooRequest) returns (Foo);
+}
+
+message UpdateFooRequest {
+  Foo foo = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/field_mask.proto";
+
+service FooAPI {
+  rpc UpdateFoo(UpdateFooRequest) returns (Foo);
+}
+
+message UpdateFooRequest {
+  Foo foo = 1;
+  google.protobuf.FieldMask update_mask = 2;
+}
+`,
+	},
+	"WKT_DIRECTLY_IMPORTED": {
+		Rationale: `The Well-Known Types are shipped with protoc, and must be imported with their canonical path so that generators map them to the correct packages.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "include/google/protobuf/timestamp.proto";
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/timestamp.proto";
+`,
+	},
+	"WKT_DURATION_SUFFIX": {
+		Rationale: `A consistent suffix makes it obvious that a field is a duration, in every generated language and in JSON.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/duration.proto";
+
+message Foo {
+  google.protobuf.Duration timeout = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/duration.proto";
+
+message Foo {
+  google.protobuf.Duration timeout_duration = 1;
+}
+`,
+	},
+	"WKT_FIELD_SUFFIXES": {
+		Rationale: `A consistent suffix makes it obvious that a field is a duration or a point in time. Types are resolved by compiling the files, so fields that refer to the well-known types with a leading period or from within the google.protobuf package are checked as well.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/duration.proto";
+import "google/protobuf/timestamp.proto";
+
+message Foo {
+  .google.protobuf.Duration timeout = 1;
+  .google.protobuf.Timestamp create = 2;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/duration.proto";
+import "google/protobuf/timestamp.proto";
+
+message Foo {
+  .google.protobuf.Duration timeout_duration = 1;
+  .google.protobuf.Timestamp create_time = 2;
+}
+`,
+	},
+	"WKT_TIMESTAMP_SUFFIX": {
+		Rationale: `A consistent suffix makes it obvious that a field is a point in time, in every generated language and in JSON.`,
+		BadExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Foo {
+  google.protobuf.Timestamp created = 1;
+}
+`,
+		GoodExample: `
+syntax = "proto3";
+
+package foo.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Foo {
+  google.protobuf.Timestamp create_time = 1;
+}
+`,
+	},
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emicklei/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/location"
+	"github.com/uber/prototool/internal/settings"
+	"github.com/uber/prototool/internal/text"
+)
+
+const (
+	testDocDirPath     = "/example"
+	testDocFilePrefix  = "// File: "
+	testDocDefaultFile = "example.proto"
+	testDocFileHeader  = "// Copyright (c) 2019 Example, Inc."
+)
+
+// testDocDeprecationTime is the time that all lines of the examples
+// were last changed according to git blame.
+var testDocDeprecationTime = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestDocs(t *testing.T) {
+	oldGetDeprecationLineTimes := getDeprecationLineTimes
+	defer func() { getDeprecationLineTimes = oldGetDeprecationLineTimes }()
+	getDeprecationLineTimes = func(string) (map[int]time.Time, error) {
+		lineTimes := make(map[int]time.Time)
+		for i := 1; i <= 100; i++ {
+			lineTimes[i] = testDocDeprecationTime
+		}
+		return lineTimes, nil
+	}
+	for _, linter := range AllLinters {
+		linter := linter
+		t.Run(linter.ID(), func(t *testing.T) {
+			doc := linter.Doc()
+			require.NotNil(t, doc, "no docs for %s", linter.ID())
+			assert.NotEmpty(t, doc.Rationale)
+			assert.NotEmpty(t, checkTestDocExample(t, linter, doc.BadExample), "bad example for %s did not fail", linter.ID())
+			assert.Empty(t, checkTestDocExample(t, linter, doc.GoodExample), "good example for %s failed", linter.ID())
+		})
+	}
+	for id := range _idToDoc {
+		_, ok := GetLinter(id)
+		assert.True(t, ok, "docs for unknown linter %s", id)
+	}
+}
+
+func checkTestDocExample(t *testing.T, linter Linter, example string) []*text.Failure {
+	require.NotEmpty(t, example)
+	protoSet := &file.ProtoSet{
+		WorkDirPath: testDocDirPath,
+		DirPath:     testDocDirPath,
+		Config: settings.Config{
+			DirPath: testDocDirPath,
+			Lint: settings.LintConfig{
+				FileHeader: testDocFileHeader,
+				Limits: settings.LintLimits{
+					MaxMessageFields: 3,
+					MaxMessageDepth:  2,
+					MaxServiceRPCs:   2,
+					MaxFileLines:     10,
+					MaxFileImports:   2,
+				},
+				DeprecationMaxAge:             90 * 24 * time.Hour,
+				ExtensionsAllowedMessages:     []string{"google.protobuf.FieldOptions"},
+				Proto3OptionalAllowedMessages: []string{"foo.v1.FooPatch"},
+				ImportRules: []settings.LintImportRule{
+					{
+						DirPattern:    "**",
+						AllowPatterns: []string{"foo/**", "google/protobuf/*.proto"},
+						DenyPatterns:  []string{"foo/internal/**"},
+					},
+				},
+			},
+		},
+	}
+	filenameToFileData := splitTestDocExample(example)
+	var fileDescriptorProtos *fileDescriptorProtoMap
+	if needsFileDescriptorSets(linter) {
+		fileDescriptorProtos = getTestDocFileDescriptorProtos(t, filenameToFileData)
+	}
+	var descriptors []*FileDescriptor
+	for filename, fileData := range filenameToFileData {
+		parser := proto.NewParser(strings.NewReader(fileData))
+		parser.Filename(filename)
+		descriptor, err := parser.Parse()
+		require.NoError(t, err, "%s: %s", filename, fileData)
+		fileDescriptor := &FileDescriptor{
+			Proto:    descriptor,
+			ProtoSet: protoSet,
+			FileData: fileData,
+		}
+		if fileDescriptorProto, ok := fileDescriptorProtos.get(filename); ok {
+			fileDescriptor.FileDescriptorProto = fileDescriptorProto
+			fileDescriptor.Finder = location.NewFinder(fileDescriptorProto.GetSourceCodeInfo())
+		}
+		descriptors = append(descriptors, fileDescriptor)
+	}
+	failures, err := linter.Check(testDocDirPath, descriptors)
+	require.NoError(t, err)
+	return failures
+}
+
+// getTestDocFileDescriptorProtos compiles the example files with source
+// code info, as protoc would for the Linters from NewDescriptorLinter.
+func getTestDocFileDescriptorProtos(t *testing.T, filenameToFileData map[string]string) *fileDescriptorProtoMap {
+	filenames := make([]string, 0, len(filenameToFileData))
+	for filename := range filenameToFileData {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	parser := protoparse.Parser{
+		Accessor: func(filename string) (io.ReadCloser, error) {
+			fileData, ok := filenameToFileData[filename]
+			if !ok {
+				return nil, os.ErrNotExist
+			}
+			return ioutil.NopCloser(strings.NewReader(fileData)), nil
+		},
+		IncludeSourceCodeInfo: true,
+	}
+	fileDescriptors, err := parser.ParseFiles(filenames...)
+	require.NoError(t, err)
+	fileDescriptorSet := &descriptor.FileDescriptorSet{}
+	for _, fileDescriptor := range fileDescriptors {
+		fileDescriptorSet.File = append(fileDescriptorSet.File, fileDescriptor.AsFileDescriptorProto())
+	}
+	return newFileDescriptorProtoMap([]*descriptor.FileDescriptorSet{fileDescriptorSet})
+}
+
+func splitTestDocExample(example string) map[string]string {
+	filenameToFileData := make(map[string]string)
+	filename := testDocDefaultFile
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(example), "\n") {
+		if strings.HasPrefix(line, testDocFilePrefix) {
+			if len(lines) > 0 {
+				filenameToFileData[filename] = strings.Join(lines, "\n") + "\n"
+			}
+			filename = strings.TrimPrefix(line, testDocFilePrefix)
+			lines = nil
+			continue
+		}
+		lines = append(lines, line)
+	}
+	filenameToFileData[filename] = strings.Join(lines, "\n") + "\n"
+	return filenameToFileData
+}
@@ -0,0 +1,697 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/scanner"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/strs"
+	"github.com/uber/prototool/internal/text"
+	"go.uber.org/zap"
+)
+
+type fixer struct {
+	logger        *zap.Logger
+	allowBreaking bool
+}
+
+func newFixer(options ...FixerOption) *fixer {
+	fixer := &fixer{
+		logger: zap.NewNop(),
+	}
+	for _, option := range options {
+		option(fixer)
+	}
+	return fixer
+}
+
+func (f *fixer) Fix(protoSet *file.ProtoSet) (map[string][]byte, []*text.Failure, error) {
+	linters, err := GetLinters(protoSet.Config.Lint)
+	if err != nil {
+		return nil, nil, err
+	}
+	dirPathToDescriptors, err := GetDirPathToDescriptors(protoSet)
+	if err != nil {
+		return nil, nil, err
+	}
+	displayPathToPath := make(map[string]string)
+	for _, protoFiles := range protoSet.DirPathToFiles {
+		for _, protoFile := range protoFiles {
+			displayPathToPath[protoFile.DisplayPath] = protoFile.Path
+		}
+	}
+	var descriptors []*FileDescriptor
+	for _, dirDescriptors := range dirPathToDescriptors {
+		descriptors = append(descriptors, dirDescriptors...)
+	}
+	// sort for deterministic failures and edits
+	sort.Slice(descriptors, func(i int, j int) bool { return descriptors[i].Filename < descriptors[j].Filename })
+
+	state := newFixState(linters, protoSet.Config.Lint.IgnoreIDToFilePaths)
+	for _, descriptor := range descriptors {
+		if err := state.addDescriptor(descriptor); err != nil {
+			return nil, nil, err
+		}
+	}
+	failures := state.filterRenames(f.allowBreaking)
+	for _, rename := range state.renames {
+		f.logger.Debug("renaming", zap.String("file", rename.descriptor.Filename), zap.String("from", rename.oldName), zap.String("to", rename.newName))
+	}
+	filenameToEdits, err := state.getEdits()
+	if err != nil {
+		return nil, nil, err
+	}
+	pathToData := make(map[string][]byte, len(filenameToEdits))
+	for _, descriptor := range descriptors {
+		edits, ok := filenameToEdits[descriptor.Filename]
+		if !ok {
+			continue
+		}
+		data, err := applyFixEdits(descriptor.FileData, edits)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", descriptor.Filename, err)
+		}
+		path, ok := displayPathToPath[descriptor.Filename]
+		if !ok {
+			return nil, nil, fmt.Errorf("no file path for %s", descriptor.Filename)
+		}
+		pathToData[path] = []byte(data)
+	}
+	SetSeverities(protoSet.Config.Lint, linters, failures)
+	text.SortFailures(failures)
+	return pathToData, failures, nil
+}
+
+// fixRename is the rename of a single declaration.
+type fixRename struct {
+	descriptor *FileDescriptor
+	position   scanner.Position
+	// the keywords, types or punctuation that precede the name in the
+	// declaration, in order
+	prefixes []string
+	// the kind of declaration, used in failure messages
+	kind string
+	// the full name of the scope the name is declared in
+	scope   string
+	oldName string
+	newName string
+	lintIDs []string
+	// if not empty, the reason this rename is wire or JSON breaking
+	breaking string
+	// set for messages and enums, the full name before renaming
+	typeFullName string
+}
+
+// fixReference is a reference to a type.
+type fixReference struct {
+	descriptor *FileDescriptor
+	position   scanner.Position
+	// the keywords, names or punctuation that precede the reference in
+	// the declaration, in order
+	prefixes []string
+	// the scope the reference is resolved from, as components
+	scope []string
+	value string
+}
+
+type fixState struct {
+	idToLinter          map[string]Linter
+	ignoreIDToFilePaths map[string][]string
+
+	renames    []*fixRename
+	references []*fixReference
+	// all full type names in the ProtoSet, before renaming
+	typeFullNames map[string]struct{}
+	// all names in each scope, before renaming, with their count
+	scopeToNames map[string]map[string]int
+}
+
+func newFixState(linters []Linter, ignoreIDToFilePaths map[string][]string) *fixState {
+	idToLinter := make(map[string]Linter, len(linters))
+	for _, linter := range linters {
+		idToLinter[linter.ID()] = linter
+	}
+	return &fixState{
+		idToLinter:          idToLinter,
+		ignoreIDToFilePaths: ignoreIDToFilePaths,
+		typeFullNames:       make(map[string]struct{}),
+		scopeToNames:        make(map[string]map[string]int),
+	}
+}
+
+func (s *fixState) addDescriptor(descriptor *FileDescriptor) error {
+	var scope []string
+	for _, element := range descriptor.Elements {
+		if pkg, ok := element.(*proto.Package); ok {
+			scope = strings.Split(pkg.Name, ".")
+		}
+	}
+	return s.addElements(descriptor, scope, descriptor.Elements)
+}
+
+func (s *fixState) addElements(descriptor *FileDescriptor, scope []string, elements []proto.Visitee) error {
+	scopeName := strings.Join(scope, ".")
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.Message:
+			if e.IsExtend {
+				s.addReference(descriptor, e.Position, []string{"extend"}, scope, e.Name)
+				for _, child := range e.Elements {
+					if field, ok := child.(*proto.NormalField); ok {
+						s.addFieldReference(descriptor, field.Field, scope)
+					}
+				}
+				continue
+			}
+			s.addType(descriptor, e.Position, "message", scope, e.Name, messageNamesCamelCaseLinter, messageNamesCapitalizedLinter)
+			if err := s.addElements(descriptor, append(copyStrings(scope), e.Name), e.Elements); err != nil {
+				return err
+			}
+		case *proto.Enum:
+			s.addType(descriptor, e.Position, "enum", scope, e.Name, enumNamesCamelCaseLinter, enumNamesCapitalizedLinter)
+			for _, child := range e.Elements {
+				if enumField, ok := child.(*proto.EnumField); ok {
+					s.addEnumField(descriptor, scope, e, enumField)
+				}
+			}
+		case *proto.Service:
+			s.addName(scopeName, e.Name)
+			if newName, lintIDs := s.getCamelCaseName(descriptor, e.Name, serviceNamesCamelCaseLinter, serviceNamesCapitalizedLinter); len(lintIDs) > 0 {
+				s.renames = append(s.renames, &fixRename{
+					descriptor: descriptor,
+					position:   e.Position,
+					prefixes:   []string{"service"},
+					kind:       "service",
+					scope:      scopeName,
+					oldName:    e.Name,
+					newName:    newName,
+					lintIDs:    lintIDs,
+					breaking:   "the service name is part of the RPC paths",
+				})
+			}
+			if err := s.addElements(descriptor, append(copyStrings(scope), e.Name), e.Elements); err != nil {
+				return err
+			}
+		case *proto.RPC:
+			s.addName(scopeName, e.Name)
+			if newName, lintIDs := s.getCamelCaseName(descriptor, e.Name, rpcNamesCamelCaseLinter, rpcNamesCapitalizedLinter); len(lintIDs) > 0 {
+				s.renames = append(s.renames, &fixRename{
+					descriptor: descriptor,
+					position:   e.Position,
+					prefixes:   []string{"rpc"},
+					kind:       "RPC",
+					scope:      scopeName,
+					oldName:    e.Name,
+					newName:    newName,
+					lintIDs:    lintIDs,
+					breaking:   "the RPC name is part of the RPC path",
+				})
+			}
+			// the service name is part of the scope, but types cannot be defined in services
+			typeScope := scope[:len(scope)-1]
+			s.addReference(descriptor, e.Position, []string{"rpc", e.Name, "("}, typeScope, e.RequestType)
+			s.addReference(descriptor, e.Position, []string{"returns", "("}, typeScope, e.ReturnsType)
+		case *proto.NormalField:
+			s.addField(descriptor, nil, scope, e.Field)
+			s.addFieldReference(descriptor, e.Field, scope)
+		case *proto.MapField:
+			s.addField(descriptor, []string{">"}, scope, e.Field)
+			s.addReference(descriptor, e.Position, []string{","}, scope, e.Type)
+		case *proto.Oneof:
+			s.addName(scopeName, e.Name)
+			if s.isEnabled(descriptor, oneofNamesLowerSnakeCaseLinter) && !strs.IsLowerSnakeCase(e.Name) {
+				s.renames = append(s.renames, &fixRename{
+					descriptor: descriptor,
+					position:   e.Position,
+					prefixes:   []string{"oneof"},
+					kind:       "oneof",
+					scope:      scopeName,
+					oldName:    e.Name,
+					newName:    strs.ToLowerSnakeCase(e.Name),
+					lintIDs:    []string{oneofNamesLowerSnakeCaseLinter.ID()},
+				})
+			}
+			for _, child := range e.Elements {
+				if field, ok := child.(*proto.OneOfField); ok {
+					s.addField(descriptor, nil, scope, field.Field)
+					s.addFieldReference(descriptor, field.Field, scope)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (s *fixState) addType(descriptor *FileDescriptor, position scanner.Position, kind string, scope []string, name string, camelCaseLinter Linter, capitalizedLinter Linter) {
+	scopeName := strings.Join(scope, ".")
+	fullName := joinFullName(scopeName, name)
+	s.typeFullNames[fullName] = struct{}{}
+	s.addName(scopeName, name)
+	if newName, lintIDs := s.getCamelCaseName(descriptor, name, camelCaseLinter, capitalizedLinter); len(lintIDs) > 0 {
+		s.renames = append(s.renames, &fixRename{
+			descriptor:   descriptor,
+			position:     position,
+			prefixes:     []string{kind},
+			kind:         kind,
+			scope:        scopeName,
+			oldName:      name,
+			newName:      newName,
+			lintIDs:      lintIDs,
+			breaking:     "the full name of the type is used by google.protobuf.Any",
+			typeFullName: fullName,
+		})
+	}
+}
+
+func (s *fixState) addField(descriptor *FileDescriptor, prefixes []string, scope []string, field *proto.Field) {
+	scopeName := strings.Join(scope, ".")
+	s.addName(scopeName, field.Name)
+	newName := field.Name
+	var lintIDs []string
+	if s.isEnabled(descriptor, messageFieldNamesLowerSnakeCaseLinter) && !strs.IsLowerSnakeCase(field.Name) {
+		newName = strs.ToLowerSnakeCase(newName)
+		lintIDs = append(lintIDs, messageFieldNamesLowerSnakeCaseLinter.ID())
+	}
+	if s.isEnabled(descriptor, messageFieldNamesLowercaseLinter) && !strs.IsLowercase(field.Name) {
+		newName = strings.ToLower(newName)
+		lintIDs = append(lintIDs, messageFieldNamesLowercaseLinter.ID())
+	}
+	if len(lintIDs) == 0 {
+		return
+	}
+	if prefixes == nil {
+		prefixes = []string{field.Type}
+	}
+	var breaking string
+	if !hasJSONNameOption(field) && getJSONName(field.Name) != getJSONName(newName) {
+		breaking = fmt.Sprintf("the JSON name changes from %q to %q", getJSONName(field.Name), getJSONName(newName))
+	}
+	s.renames = append(s.renames, &fixRename{
+		descriptor: descriptor,
+		position:   field.Position,
+		prefixes:   prefixes,
+		kind:       "field",
+		scope:      scopeName,
+		oldName:    field.Name,
+		newName:    newName,
+		lintIDs:    lintIDs,
+		breaking:   breaking,
+	})
+}
+
+func (s *fixState) addEnumField(descriptor *FileDescriptor, scope []string, enum *proto.Enum, enumField *proto.EnumField) {
+	// enum values are scoped to the parent of the enum, per C++ scoping rules
+	scopeName := strings.Join(scope, ".")
+	s.addName(scopeName, enumField.Name)
+	newName := enumField.Name
+	var lintIDs []string
+	if s.isEnabled(descriptor, enumFieldNamesUpperSnakeCaseLinter) && !strs.IsUpperSnakeCase(enumField.Name) {
+		newName = strs.ToUpperSnakeCase(newName)
+		lintIDs = append(lintIDs, enumFieldNamesUpperSnakeCaseLinter.ID())
+	}
+	if s.isEnabled(descriptor, enumFieldNamesUppercaseLinter) && !strs.IsUppercase(enumField.Name) {
+		newName = strings.ToUpper(newName)
+		lintIDs = append(lintIDs, enumFieldNamesUppercaseLinter.ID())
+	}
+	prefixLinter := enumFieldPrefixesExceptMessageLinter
+	parents := []proto.Visitee{enum}
+	if s.isEnabled(descriptor, enumFieldPrefixesLinter) {
+		prefixLinter = enumFieldPrefixesLinter
+		for parent := enum.Parent; parent != nil; {
+			message, ok := parent.(*proto.Message)
+			if !ok {
+				break
+			}
+			parents = append([]proto.Visitee{message}, parents...)
+			parent = message.Parent
+		}
+	}
+	if s.isEnabled(descriptor, prefixLinter) {
+		// the prefix is computed from the names of the enum and the enclosing
+		// messages after they are fixed, as this is what will be linted
+		var nestedNames []string
+		var oldNestedNames []string
+		for _, parent := range parents {
+			switch p := parent.(type) {
+			case *proto.Message:
+				name, _ := s.getCamelCaseName(descriptor, p.Name, messageNamesCamelCaseLinter, messageNamesCapitalizedLinter)
+				nestedNames = append(nestedNames, strs.ToUpperSnakeCase(name))
+				oldNestedNames = append(oldNestedNames, strs.ToUpperSnakeCase(p.Name))
+			case *proto.Enum:
+				name, _ := s.getCamelCaseName(descriptor, p.Name, enumNamesCamelCaseLinter, enumNamesCapitalizedLinter)
+				nestedNames = append(nestedNames, strs.ToUpperSnakeCase(name))
+				oldNestedNames = append(oldNestedNames, strs.ToUpperSnakeCase(p.Name))
+			}
+		}
+		prefixedName, changed := getPrefixedEnumFieldName(newName, nestedNames)
+		if changed {
+			newName = prefixedName
+		}
+		if _, failed := getPrefixedEnumFieldName(enumField.Name, oldNestedNames); changed || failed {
+			lintIDs = append(lintIDs, prefixLinter.ID())
+		}
+	}
+	if len(lintIDs) == 0 {
+		return
+	}
+	s.renames = append(s.renames, &fixRename{
+		descriptor: descriptor,
+		position:   enumField.Position,
+		kind:       "enum value",
+		scope:      scopeName,
+		oldName:    enumField.Name,
+		newName:    newName,
+		lintIDs:    lintIDs,
+		breaking:   "enum values are serialized by name in JSON",
+	})
+}
+
+func (s *fixState) addFieldReference(descriptor *FileDescriptor, field *proto.Field, scope []string) {
+	s.addReference(descriptor, field.Position, nil, scope, field.Type)
+}
+
+func (s *fixState) addReference(descriptor *FileDescriptor, position scanner.Position, prefixes []string, scope []string, value string) {
+	if value == "" {
+		return
+	}
+	s.references = append(s.references, &fixReference{
+		descriptor: descriptor,
+		position:   position,
+		prefixes:   prefixes,
+		scope:      copyStrings(scope),
+		value:      value,
+	})
+}
+
+func (s *fixState) addName(scopeName string, name string) {
+	names, ok := s.scopeToNames[scopeName]
+	if !ok {
+		names = make(map[string]int)
+		s.scopeToNames[scopeName] = names
+	}
+	names[name]++
+}
+
+// getCamelCaseName returns the fixed name for a message, enum, service or RPC
+// and the IDs of the linters that result in the fix. If there are no IDs,
+// the name is returned unchanged.
+func (s *fixState) getCamelCaseName(descriptor *FileDescriptor, name string, camelCaseLinter Linter, capitalizedLinter Linter) (string, []string) {
+	newName := name
+	var lintIDs []string
+	if s.isEnabled(descriptor, camelCaseLinter) && !strs.IsCamelCase(name) {
+		newName = strs.ToUpperCamelCase(newName)
+		lintIDs = append(lintIDs, camelCaseLinter.ID())
+	}
+	if s.isEnabled(descriptor, capitalizedLinter) && !strs.IsCapitalized(name) {
+		r, size := utf8.DecodeRuneInString(newName)
+		newName = string(unicode.ToUpper(r)) + newName[size:]
+		lintIDs = append(lintIDs, capitalizedLinter.ID())
+	}
+	return newName, lintIDs
+}
+
+func (s *fixState) isEnabled(descriptor *FileDescriptor, linter Linter) bool {
+	if _, ok := s.idToLinter[linter.ID()]; !ok {
+		return false
+	}
+	ignore, err := shouldIgnore(linter, descriptor, s.ignoreIDToFilePaths)
+	return err == nil && !ignore
+}
+
+// filterRenames removes the renames that cannot be applied, and returns
+// failures for each of them.
+func (s *fixState) filterRenames(allowBreaking bool) []*text.Failure {
+	var failures []*text.Failure
+	// count the names in each scope after renaming to detect conflicts
+	scopeToNewNames := make(map[string]map[string]int, len(s.scopeToNames))
+	for scopeName, names := range s.scopeToNames {
+		newNames := make(map[string]int, len(names))
+		for name, count := range names {
+			newNames[name] = count
+		}
+		scopeToNewNames[scopeName] = newNames
+	}
+	for _, rename := range s.renames {
+		scopeToNewNames[rename.scope][rename.oldName]--
+		scopeToNewNames[rename.scope][rename.newName]++
+	}
+	renames := make([]*fixRename, 0, len(s.renames))
+	for _, rename := range s.renames {
+		var reason string
+		switch {
+		case rename.oldName == rename.newName:
+			// the linters do not agree with the fix, for example if the name
+			// contains characters that cannot be fixed
+			continue
+		case scopeToNewNames[rename.scope][rename.newName] > 1:
+			reason = "it would conflict with another name"
+		case rename.breaking != "" && !allowBreaking:
+			reason = fmt.Sprintf("the fix is breaking, %s. Use --allow-breaking-fixes to apply it anyway", rename.breaking)
+		default:
+			renames = append(renames, rename)
+			continue
+		}
+		// one failure for each linter, as each of them would otherwise have failed
+		for _, lintID := range rename.lintIDs {
+			failures = append(failures, text.NewFailuref(rename.position, lintID, `Not fixing %s %q to %q as %s.`, rename.kind, rename.oldName, rename.newName, reason))
+		}
+	}
+	s.renames = renames
+	return failures
+}
+
+// getEdits returns the edits for the renames and the references to renamed
+// types, keyed by filename.
+func (s *fixState) getEdits() (map[string][]*fixEdit, error) {
+	filenameToEdits := make(map[string][]*fixEdit)
+	typeFullNameToNewName := make(map[string]string)
+	for _, rename := range s.renames {
+		if rename.typeFullName != "" {
+			typeFullNameToNewName[rename.typeFullName] = rename.newName
+		}
+		offset, err := findFixToken(rename.descriptor.FileData, rename.position.Offset, rename.prefixes, rename.oldName)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", rename.position, err)
+		}
+		filenameToEdits[rename.descriptor.Filename] = append(filenameToEdits[rename.descriptor.Filename], &fixEdit{
+			offset:  offset,
+			oldText: rename.oldName,
+			newText: rename.newName,
+		})
+	}
+	if len(typeFullNameToNewName) == 0 {
+		return filenameToEdits, nil
+	}
+	for _, reference := range s.references {
+		fullName, ok := s.resolveReference(reference)
+		if !ok {
+			continue
+		}
+		newValue := getRenamedReference(reference.value, fullName, typeFullNameToNewName)
+		if newValue == reference.value {
+			continue
+		}
+		offset, err := findFixToken(reference.descriptor.FileData, reference.position.Offset, reference.prefixes, reference.value)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", reference.position, err)
+		}
+		filenameToEdits[reference.descriptor.Filename] = append(filenameToEdits[reference.descriptor.Filename], &fixEdit{
+			offset:  offset,
+			oldText: reference.value,
+			newText: newValue,
+		})
+	}
+	return filenameToEdits, nil
+}
+
+// resolveReference resolves the reference to the full name of a type in
+// the ProtoSet, searching from the innermost scope outwards.
+func (s *fixState) resolveReference(reference *fixReference) (string, bool) {
+	if strings.HasPrefix(reference.value, ".") {
+		fullName := strings.TrimPrefix(reference.value, ".")
+		_, ok := s.typeFullNames[fullName]
+		return fullName, ok
+	}
+	for i := len(reference.scope); i >= 0; i-- {
+		fullName := joinFullName(strings.Join(reference.scope[:i], "."), reference.value)
+		if _, ok := s.typeFullNames[fullName]; ok {
+			return fullName, true
+		}
+	}
+	return "", false
+}
+
+// getRenamedReference returns the reference with the renamed types applied.
+//
+// The components of the reference are the trailing components of the full
+// name, and renames do not change the number of components.
+func getRenamedReference(value string, fullName string, typeFullNameToNewName map[string]string) string {
+	components := strings.Split(fullName, ".")
+	for i := range components {
+		if newName, ok := typeFullNameToNewName[strings.Join(strings.Split(fullName, ".")[:i+1], ".")]; ok {
+			components[i] = newName
+		}
+	}
+	numValueComponents := len(strings.Split(strings.TrimPrefix(value, "."), "."))
+	newValue := strings.Join(components[len(components)-numValueComponents:], ".")
+	if strings.HasPrefix(value, ".") {
+		return "." + newValue
+	}
+	return newValue
+}
+
+// getPrefixedEnumFieldName returns the enum field name with the prefix
+// of the nested names, and true if the name changed.
+//
+// If the name already has a partial prefix, such as only the name of the
+// enum, the partial prefix is replaced.
+func getPrefixedEnumFieldName(name string, nestedNames []string) (string, bool) {
+	expectedPrefix := strings.Join(nestedNames, "_") + "_"
+	if strings.HasPrefix(name, expectedPrefix) {
+		return name, false
+	}
+	for i := 1; i < len(nestedNames); i++ {
+		if partialPrefix := strings.Join(nestedNames[i:], "_") + "_"; strings.HasPrefix(name, partialPrefix) {
+			return expectedPrefix + strings.TrimPrefix(name, partialPrefix), true
+		}
+	}
+	return expectedPrefix + name, true
+}
+
+// getJSONName returns the JSON name that protoc derives from the field name.
+func getJSONName(name string) string {
+	var output []rune
+	upperNext := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upperNext = true
+		case upperNext:
+			output = append(output, unicode.ToUpper(r))
+			upperNext = false
+		default:
+			output = append(output, r)
+		}
+	}
+	return string(output)
+}
+
+func hasJSONNameOption(field *proto.Field) bool {
+	for _, option := range field.Options {
+		if option.Name == "json_name" {
+			return true
+		}
+	}
+	return false
+}
+
+// fixEdit is the replacement of text at an offset.
+type fixEdit struct {
+	offset  int
+	oldText string
+	newText string
+}
+
+func applyFixEdits(data string, edits []*fixEdit) (string, error) {
+	sort.Slice(edits, func(i int, j int) bool { return edits[i].offset > edits[j].offset })
+	previousOffset := -1
+	for _, edit := range edits {
+		if edit.offset == previousOffset {
+			// the same token, for example a type referenced for both
+			// the request and response of an RPC
+			continue
+		}
+		if !strings.HasPrefix(data[edit.offset:], edit.oldText) {
+			return "", fmt.Errorf("expected %q at offset %d", edit.oldText, edit.offset)
+		}
+		data = data[:edit.offset] + edit.newText + data[edit.offset+len(edit.oldText):]
+		previousOffset = edit.offset
+	}
+	return data, nil
+}
+
+// findFixToken returns the offset of the token in the data, starting at
+// offset and after each of the given prefixes in order.
+//
+// Prefixes that are identifiers must match whole tokens, other prefixes
+// such as "(" match anywhere.
+func findFixToken(data string, offset int, prefixes []string, token string) (int, error) {
+	for _, prefix := range prefixes {
+		var prefixOffset int
+		var err error
+		if isFixToken(prefix) {
+			prefixOffset, err = findFixToken(data, offset, nil, prefix)
+		} else if index := strings.Index(data[offset:], prefix); index >= 0 {
+			prefixOffset = offset + index
+		} else {
+			err = fmt.Errorf("could not find %q", prefix)
+		}
+		if err != nil {
+			return 0, err
+		}
+		offset = prefixOffset + len(prefix)
+	}
+	for {
+		index := strings.Index(data[offset:], token)
+		if index < 0 {
+			return 0, fmt.Errorf("could not find %q", token)
+		}
+		start := offset + index
+		end := start + len(token)
+		if (start == 0 || !isFixTokenChar(data[start-1])) && (end == len(data) || !isFixTokenChar(data[end])) {
+			return start, nil
+		}
+		offset = end
+	}
+}
+
+func isFixToken(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isFixTokenChar(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isFixTokenChar(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+func joinFullName(scopeName string, name string) string {
+	if scopeName == "" {
+		return name
+	}
+	return scopeName + "." + name
+}
+
+func copyStrings(s []string) []string {
+	c := make([]string, len(s))
+	copy(c, s)
+	return c
+}
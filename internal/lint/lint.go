@@ -25,11 +25,14 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"unicode"
 
 	"github.com/emicklei/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/uber/prototool/internal/file"
+	"github.com/uber/prototool/internal/location"
 	"github.com/uber/prototool/internal/settings"
 	"github.com/uber/prototool/internal/text"
 	"go.uber.org/zap"
@@ -40,19 +43,32 @@ var (
 	AllLinters = []Linter{
 		commentsNoCStyleLinter,
 		commentsNoInlineLinter,
+		commentsNoTodosLinter,
+		commentsNotRestatingNamesLinter,
+		commentsSpelledCorrectlyLinter,
+		deprecatedMessagesNotReferencedLinter,
+		deprecationsHaveCommentsLinter,
+		deprecationsNotExpiredLinter,
 		enumFieldNamesUppercaseLinter,
 		enumFieldNamesUpperSnakeCaseLinter,
 		enumFieldPrefixesLinter,
 		enumFieldPrefixesExceptMessageLinter,
 		enumNamesCamelCaseLinter,
 		enumNamesCapitalizedLinter,
+		enumValuesContiguousLinter,
 		enumZeroValuesInvalidLinter,
 		enumZeroValuesInvalidExceptMessageLinter,
 		enumsHaveCommentsLinter,
 		enumsHaveSentenceCommentsLinter,
 		enumsNoAllowAliasLinter,
+		extensionsAllowedLinter,
+		fieldsNoDefaultValuesLinter,
+		fieldsNotProto3OptionalLinter,
+		fieldsNotRequiredLinter,
 		fieldsNotReservedLinter,
 		fileHeaderLinter,
+		fileMaxImportsLinter,
+		fileMaxLinesLinter,
 		fileNamesLowerSnakeCaseLinter,
 		fileOptionsCSharpNamespaceSameInDirLinter,
 		fileOptionsEqualCSharpNamespaceCapitalizedLinter,
@@ -79,9 +95,17 @@ var (
 		fileOptionsUnsetJavaMultipleFilesLinter,
 		fileOptionsUnsetJavaOuterClassnameLinter,
 		gogoNotImportedLinter,
+		importsAllowedLinter,
+		importsNotDeniedLinter,
 		importsNotPublicLinter,
 		importsNotWeakLinter,
+		listRequestsPaginatedLinter,
+		listResponsesNextPageTokenLinter,
+		messageFieldNumbersNoGapsLinter,
+		messageFieldNumbersNotImplementationReservedLinter,
+		messageFieldsConsistentLinter,
 		messageFieldsDurationLinter,
+		messageFieldsInNumberOrderLinter,
 		messageFieldsNotFloatsLinter,
 		messageFieldsNoJSONNameLinter,
 		messageFieldsTimeLinter,
@@ -89,15 +113,19 @@ var (
 		messageFieldNamesFilepathLinter,
 		messageFieldNamesLowerSnakeCaseLinter,
 		messageFieldNamesLowercaseLinter,
+		messageMaxDepthLinter,
+		messageMaxFieldsLinter,
 		messageNamesCamelCaseLinter,
 		messageNamesCapitalizedLinter,
 		messagesHaveCommentsLinter,
 		messagesHaveCommentsExceptRequestResponseTypesLinter,
 		messagesHaveSentenceCommentsExceptRequestResponseTypesLinter,
+		messagesNoGroupsLinter,
 		messagesNotEmptyExceptRequestResponseTypesLinter,
 		namesNoCommonLinter,
 		namesNoDataLinter,
 		namesNoUUIDLinter,
+		namesSpelledCorrectlyLinter,
 		oneofNamesLowerSnakeCaseLinter,
 		packageIsDeclaredLinter,
 		packageLowerCaseLinter,
@@ -109,28 +137,54 @@ var (
 		rpcsHaveSentenceCommentsLinter,
 		rpcNamesCamelCaseLinter,
 		rpcNamesCapitalizedLinter,
+		rpcOptionsGoogleAPIHTTPMatchMethodLinter,
 		rpcOptionsNoGoogleAPIHTTPLinter,
 		requestResponseTypesAfterServiceLinter,
 		requestResponseTypesInSameFileLinter,
 		requestResponseTypesOnlyInFileLinter,
 		requestResponseTypesUniqueLinter,
 		requestResponseNamesMatchRPCLinter,
+		reservedNumbersHaveNamesLinter,
 		servicesHaveCommentsLinter,
 		servicesHaveSentenceCommentsLinter,
+		serviceMaxRPCsLinter,
 		serviceNamesAPISuffixLinter,
 		serviceNamesCamelCaseLinter,
 		serviceNamesCapitalizedLinter,
 		serviceNamesMatchFileNameLinter,
 		serviceNamesNoPluralsLinter,
+		standardMethodTypesLinter,
 		syntaxProto3Linter,
+		updateRequestsUpdateMaskLinter,
 		wktDirectlyImportedLinter,
 		wktDurationSuffixLinter,
+		wktFieldSuffixesLinter,
 		wktTimestampSuffixLinter,
 	}
 
 	// DefaultLinters is the slice of default Linters.
 	DefaultLinters = Uber1Linters
 
+	// AIPLinters is the slice of linters for the aip lint group.
+	AIPLinters = []Linter{
+		enumFieldNamesUpperSnakeCaseLinter,
+		enumNamesCamelCaseLinter,
+		enumNamesCapitalizedLinter,
+		fileHeaderLinter,
+		listRequestsPaginatedLinter,
+		listResponsesNextPageTokenLinter,
+		messageFieldNamesLowerSnakeCaseLinter,
+		messageNamesCamelCaseLinter,
+		messageNamesCapitalizedLinter,
+		rpcNamesCamelCaseLinter,
+		rpcNamesCapitalizedLinter,
+		rpcOptionsGoogleAPIHTTPMatchMethodLinter,
+		serviceNamesCamelCaseLinter,
+		serviceNamesCapitalizedLinter,
+		standardMethodTypesLinter,
+		updateRequestsUpdateMaskLinter,
+	}
+
 	// GoogleLinters is the slice of linters for the google lint group.
 	GoogleLinters = []Linter{
 		enumFieldNamesUpperSnakeCaseLinter,
@@ -266,6 +320,7 @@ var (
 
 	// GroupToLinters is the map from linter group to the corresponding slice of linters.
 	GroupToLinters = map[string][]Linter{
+		"aip":    AIPLinters,
 		"google": GoogleLinters,
 		"uber1":  Uber1Linters,
 		"uber2":  Uber2Linters,
@@ -286,6 +341,13 @@ func init() {
 // Runner runs a lint job.
 type Runner interface {
 	Run(*file.ProtoSet) ([]*text.Failure, error)
+	// GetCachedFailures returns the failures of a previous Run for the
+	// ProtoSet if no file, configuration or linter has changed since, or
+	// false if there are no such failures.
+	//
+	// This always returns false if the Runner has no cache path, or if
+	// a linter whose failures are never cached is enabled.
+	GetCachedFailures(*file.ProtoSet) ([]*text.Failure, bool, error)
 }
 
 // RunnerOption is an option for a new Runner.
@@ -300,17 +362,88 @@ func RunnerWithLogger(logger *zap.Logger) RunnerOption {
 	}
 }
 
+// RunnerWithCachePath returns a RunnerOption that caches failures in the
+// given directory, so that the failures for unchanged files are reused.
+//
+// The ProtoSet must compile before it is given to Run, as the cached
+// failures for the whole ProtoSet are also used to skip compilation.
+// The default is to not cache failures.
+func RunnerWithCachePath(cachePath string) RunnerOption {
+	return func(runner *runner) {
+		runner.cachePath = cachePath
+	}
+}
+
+// RunnerWithFileDescriptorSets returns a RunnerOption that gives the
+// FileDescriptors the compiled FileDescriptorProtos of their files, which
+// the Linters returned from NewDescriptorLinter check.
+//
+// The FileDescriptorSets should include source code info, otherwise all
+// failures are reported at the start of the file.
+// The default is to not set the FileDescriptorProtos.
+func RunnerWithFileDescriptorSets(fileDescriptorSets []*descriptor.FileDescriptorSet) RunnerOption {
+	return func(runner *runner) {
+		runner.fileDescriptorSets = fileDescriptorSets
+	}
+}
+
 // NewRunner returns a new Runner.
 func NewRunner(options ...RunnerOption) Runner {
 	return newRunner(options...)
 }
 
+// Fixer fixes naming lint failures by renaming declarations, along with
+// the references to renamed types in the ProtoSet.
+type Fixer interface {
+	// Fix returns the new file data for each file that changed, keyed by
+	// the file path, and a failure for each fix that was not applied.
+	//
+	// Files are not written to.
+	Fix(*file.ProtoSet) (map[string][]byte, []*text.Failure, error)
+}
+
+// FixerOption is an option for a new Fixer.
+type FixerOption func(*fixer)
+
+// FixerWithLogger returns a FixerOption that uses the given logger.
+//
+// The default is to use zap.NewNop().
+func FixerWithLogger(logger *zap.Logger) FixerOption {
+	return func(fixer *fixer) {
+		fixer.logger = logger
+	}
+}
+
+// FixerWithAllowBreaking returns a FixerOption that applies fixes that
+// break wire or JSON compatibility, such as renaming enum values or RPCs.
+//
+// The default is to not apply these fixes and return a failure for each.
+func FixerWithAllowBreaking() FixerOption {
+	return func(fixer *fixer) {
+		fixer.allowBreaking = true
+	}
+}
+
+// NewFixer returns a new Fixer.
+func NewFixer(options ...FixerOption) Fixer {
+	return newFixer(options...)
+}
+
 // FileDescriptor is a wrapper for proto.Proto.
 type FileDescriptor struct {
 	*proto.Proto
 
 	ProtoSet *file.ProtoSet
 	FileData string
+	// Path is the absolute path to the file.
+	Path string
+	// FileDescriptorProto is the compiled descriptor for the file, in
+	// which all type names are fully-qualified. This is nil unless the
+	// Runner was given the FileDescriptorSets for the files.
+	FileDescriptorProto *descriptor.FileDescriptorProto
+	// Finder finds the locations of the elements of FileDescriptorProto.
+	// This is nil if FileDescriptorProto is nil.
+	Finder *location.Finder
 }
 
 // The below should not be needed in the CLI
@@ -322,6 +455,12 @@ type Linter interface {
 	ID() string
 	// Return the purpose of this Linter. This should be a human-readable string.
 	Purpose(config settings.LintConfig) string
+	// Return the default severity of this Linter. This can be overridden
+	// by the lint.severities configuration.
+	DefaultSeverity() string
+	// Return the long-form documentation of this Linter, or nil if
+	// there is no such documentation.
+	Doc() *Doc
 	// Check the file data for the descriptors in a common directory.
 	// If there is a lint failure, this returns it in the
 	// slice and does not return an error. An error is returned if something
@@ -330,6 +469,45 @@ type Linter interface {
 	Check(dirPath string, descriptors []*FileDescriptor) ([]*text.Failure, error)
 }
 
+// ProtoSetLinter is a Linter that can check the descriptors of all
+// directories of a ProtoSet together, for example to compare declarations
+// across packages.
+type ProtoSetLinter interface {
+	Linter
+
+	// CheckProtoSet checks the descriptors of all directories, keyed by
+	// directory path. Check only compares the descriptors within a single
+	// directory.
+	CheckProtoSet(dirPathToDescriptors map[string][]*FileDescriptor) ([]*text.Failure, error)
+}
+
+// Doc is the long-form documentation for a Linter.
+//
+// Examples are a single file named example.proto, unless the example
+// contains lines of the form "// File: path/to/file.proto", in which
+// case each such line starts a new file with the given path.
+type Doc struct {
+	// Rationale explains why the Linter exists.
+	Rationale string
+	// BadExample is Protobuf that results in failures from the Linter.
+	BadExample string
+	// GoodExample is Protobuf that results in no failures from the Linter.
+	GoodExample string
+}
+
+// GetLinter returns the Linter in AllLinters for the given ID.
+//
+// The ID is case-insensitive.
+func GetLinter(id string) (Linter, bool) {
+	id = strings.ToUpper(id)
+	for _, linter := range AllLinters {
+		if linter.ID() == id {
+			return linter, true
+		}
+	}
+	return nil, false
+}
+
 // NewLinter is a convenience function that returns a new Linter for the
 // given parameters, using a function to record failures.
 //
@@ -351,6 +529,60 @@ func NewSuppressableLinter(id string, purpose string, suppressableAnnotation str
 	return newBaseSuppressableLinter(id, purpose, suppressableAnnotation, addCheck)
 }
 
+// NewProtoSetLinter is a convenience function that returns a new
+// ProtoSetLinter for the given parameters, using a function to record
+// failures.
+//
+// The ID will be upper-cased.
+//
+// Failures returned from check do not need to set the ID, this will be overwritten.
+func NewProtoSetLinter(id string, purpose string, addCheck func(func(*text.Failure), map[string][]*FileDescriptor) error) ProtoSetLinter {
+	return newProtoSetLinter(id, purpose, addCheck)
+}
+
+// NewDescriptorLinter is a convenience function that returns a new Linter
+// for the given parameters that checks the compiled FileDescriptorProtos of
+// the files instead of the parsed files, using a function to record failures.
+//
+// Check returns an error if a FileDescriptor does not have a
+// FileDescriptorProto, so the Runner must be given the FileDescriptorSets
+// if NeedsFileDescriptorSets returns true.
+//
+// The ID will be upper-cased.
+//
+// Failures returned from check do not need to set the ID, this will be overwritten.
+func NewDescriptorLinter(id string, purpose string, addCheck func(func(*text.Failure), string, []*FileDescriptor) error) Linter {
+	return newDescriptorLinter(id, purpose, addCheck)
+}
+
+// NeedsFileDescriptorSets returns true if any of the given Linters was
+// returned from NewDescriptorLinter.
+func NeedsFileDescriptorSets(linters []Linter) bool {
+	for _, linter := range linters {
+		if needsFileDescriptorSets(linter) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithDefaultSeverity returns a Linter that is the same as the given Linter
+// except that it has the given default severity.
+//
+// Linters have the error severity by default.
+func WithDefaultSeverity(linter Linter, defaultSeverity string) Linter {
+	return &defaultSeverityLinter{Linter: linter, defaultSeverity: defaultSeverity}
+}
+
+// GetSeverity returns the severity of failures for the given Linter, taking
+// into account the overrides in the LintConfig.
+func GetSeverity(config settings.LintConfig, linter Linter) string {
+	if severity, ok := config.IDToSeverity[linter.ID()]; ok {
+		return severity
+	}
+	return linter.DefaultSeverity()
+}
+
 // GetLinters returns the Linters for the LintConfig.
 //
 // The group, if set, is expected to be lower-case.
@@ -360,12 +592,15 @@ func NewSuppressableLinter(id string, purpose string, suppressableAnnotation str
 //
 // If the config came from the settings package, this is already validated.
 func GetLinters(config settings.LintConfig) ([]Linter, error) {
+	if err := checkLintGroups(config); err != nil {
+		return nil, err
+	}
 	var linters []Linter
-	var ok bool
+	var err error
 	if config.Group != "" {
-		linters, ok = GroupToLinters[config.Group]
-		if !ok {
-			return nil, fmt.Errorf("unknown lint group: %s", config.Group)
+		linters, err = GetGroupLinters(config, config.Group)
+		if err != nil {
+			return nil, err
 		}
 	} else if !config.NoDefault {
 		// we ignore NoDefault if Group is set
@@ -386,25 +621,121 @@ func GetLinters(config settings.LintConfig) ([]Linter, error) {
 			return nil, err
 		}
 	}
-	if len(config.IncludeIDs) == 0 && len(config.ExcludeIDs) == 0 {
+	for severityID := range config.IDToSeverity {
+		if err := checkLintID(severityID); err != nil {
+			return nil, err
+		}
+	}
+	return applyIncludeExcludeIDs(linters, config.IncludeIDs, config.ExcludeIDs), nil
+}
+
+// GetGroupLinters returns the Linters for the given lint group, which is
+// either a built-in group or a group defined in the LintConfig.
+//
+// The group is expected to be lower-case.
+func GetGroupLinters(config settings.LintConfig, group string) ([]Linter, error) {
+	return getGroupLinters(config, group, make(map[string]struct{}))
+}
+
+// GetGroups returns the sorted names of all built-in lint groups and
+// all lint groups defined in the LintConfig.
+func GetGroups(config settings.LintConfig) []string {
+	groups := make([]string, 0, len(GroupToLinters)+len(config.Groups))
+	for group := range GroupToLinters {
+		groups = append(groups, group)
+	}
+	for group := range config.Groups {
+		if _, ok := GroupToLinters[group]; !ok {
+			groups = append(groups, group)
+		}
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// GetBuiltinGroup returns the built-in lint group that the given lint group
+// is or transitively extends, or "" if there is no such group.
+func GetBuiltinGroup(config settings.LintConfig, group string) string {
+	seen := make(map[string]struct{})
+	for group != "" {
+		if _, ok := GroupToLinters[group]; ok {
+			return group
+		}
+		if _, ok := seen[group]; ok {
+			return ""
+		}
+		seen[group] = struct{}{}
+		group = config.Groups[group].Extends
+	}
+	return ""
+}
+
+func getGroupLinters(config settings.LintConfig, group string, seen map[string]struct{}) ([]Linter, error) {
+	if linters, ok := GroupToLinters[group]; ok {
 		return linters, nil
 	}
+	lintGroup, ok := config.Groups[group]
+	if !ok {
+		return nil, fmt.Errorf("unknown lint group: %s", group)
+	}
+	if _, ok := seen[group]; ok {
+		return nil, fmt.Errorf("lint group %s transitively extends itself", group)
+	}
+	seen[group] = struct{}{}
+	var linters []Linter
+	if lintGroup.Extends != "" {
+		var err error
+		linters, err = getGroupLinters(config, lintGroup.Extends, seen)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return applyIncludeExcludeIDs(linters, lintGroup.IncludeIDs, lintGroup.ExcludeIDs), nil
+}
+
+func checkLintGroups(config settings.LintConfig) error {
+	for group, lintGroup := range config.Groups {
+		if _, ok := GroupToLinters[group]; ok {
+			return fmt.Errorf("lint group %s is a built-in lint group and cannot be redefined", group)
+		}
+		for _, id := range lintGroup.IncludeIDs {
+			if err := checkLintID(id); err != nil {
+				return err
+			}
+		}
+		for _, id := range lintGroup.ExcludeIDs {
+			if err := checkLintID(id); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyIncludeExcludeIDs adds the Linters for includeIDs to linters
+// and removes the Linters for excludeIDs.
+//
+// This returns linters if both includeIDs and excludeIDs are empty.
+func applyIncludeExcludeIDs(linters []Linter, includeIDs []string, excludeIDs []string) []Linter {
+	if len(includeIDs) == 0 && len(excludeIDs) == 0 {
+		return linters
+	}
 
 	// Apply the configured linters to the default group.
-	linterMap := make(map[string]Linter, len(linters)+len(config.IncludeIDs)-len(config.ExcludeIDs))
+	linterMap := make(map[string]Linter, len(linters)+len(includeIDs))
 	for _, l := range linters {
 		linterMap[l.ID()] = l
 	}
-	if len(config.IncludeIDs) > 0 {
+	if len(includeIDs) > 0 {
 		for _, l := range AllLinters {
-			for _, id := range config.IncludeIDs {
+			for _, id := range includeIDs {
 				if l.ID() == id {
 					linterMap[id] = l
 				}
 			}
 		}
 	}
-	for _, excludeID := range config.ExcludeIDs {
+	for _, excludeID := range excludeIDs {
 		delete(linterMap, excludeID)
 	}
 
@@ -412,57 +743,84 @@ func GetLinters(config settings.LintConfig) ([]Linter, error) {
 	for _, l := range linterMap {
 		result = append(result, l)
 	}
-	return result, nil
+	return result
 }
 
 // GetDirPathToDescriptors is a convenience function that gets the
 // descriptors for the given ProtoSet.
 func GetDirPathToDescriptors(protoSet *file.ProtoSet) (map[string][]*FileDescriptor, error) {
+	return getDirPathToDescriptors(protoSet, nil)
+}
+
+func getDirPathToDescriptors(protoSet *file.ProtoSet, fileDescriptorProtos *fileDescriptorProtoMap) (map[string][]*FileDescriptor, error) {
 	dirPathToDescriptors := make(map[string][]*FileDescriptor, len(protoSet.DirPathToFiles))
 	for dirPath, protoFiles := range protoSet.DirPathToFiles {
 		// skip those files not under the directory
 		if !strings.HasPrefix(dirPath, protoSet.DirPath) {
 			continue
 		}
-		descriptors := make([]*FileDescriptor, len(protoFiles))
-		for i, protoFile := range protoFiles {
-			file, err := os.Open(protoFile.Path)
-			if err != nil {
-				return nil, err
-			}
-			parser := proto.NewParser(file)
-			parser.Filename(protoFile.DisplayPath)
-			descriptor, err := parser.Parse()
-			if err != nil {
-				_ = file.Close()
-				return nil, err
-			}
-			if _, err := file.Seek(0, 0); err != nil {
-				_ = file.Close()
-				return nil, err
-			}
-			fileData, err := ioutil.ReadAll(file)
-			if err != nil {
-				_ = file.Close()
-				return nil, err
-			}
-			_ = file.Close()
-			descriptors[i] = &FileDescriptor{
-				Proto:    descriptor,
-				ProtoSet: protoSet,
-				FileData: string(fileData),
-			}
+		descriptors, err := getDescriptors(protoSet, protoFiles, fileDescriptorProtos)
+		if err != nil {
+			return nil, err
 		}
 		dirPathToDescriptors[dirPath] = descriptors
 	}
 	return dirPathToDescriptors, nil
 }
 
+// getDescriptors parses the given files. If fileDescriptorProtos is not
+// nil, the compiled FileDescriptorProto for each file is also set.
+func getDescriptors(protoSet *file.ProtoSet, protoFiles []*file.ProtoFile, fileDescriptorProtos *fileDescriptorProtoMap) ([]*FileDescriptor, error) {
+	descriptors := make([]*FileDescriptor, len(protoFiles))
+	for i, protoFile := range protoFiles {
+		file, err := os.Open(protoFile.Path)
+		if err != nil {
+			return nil, err
+		}
+		parser := proto.NewParser(file)
+		parser.Filename(protoFile.DisplayPath)
+		descriptor, err := parser.Parse()
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		if _, err := file.Seek(0, 0); err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		fileData, err := ioutil.ReadAll(file)
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		_ = file.Close()
+		descriptors[i] = &FileDescriptor{
+			Proto:    descriptor,
+			ProtoSet: protoSet,
+			FileData: string(fileData),
+			Path:     protoFile.Path,
+		}
+		if fileDescriptorProto, ok := fileDescriptorProtos.get(protoFile.Path); ok {
+			descriptors[i].FileDescriptorProto = fileDescriptorProto
+			descriptors[i].Finder = location.NewFinder(fileDescriptorProto.GetSourceCodeInfo())
+		}
+	}
+	return descriptors, nil
+}
+
 // CheckMultiple is a convenience function that checks multiple linters and multiple descriptors.
 func CheckMultiple(linters []Linter, dirPathToDescriptors map[string][]*FileDescriptor, ignoreIDToFilePaths map[string][]string) ([]*text.Failure, error) {
 	var allFailures []*text.Failure
-	for dirPath, descriptors := range dirPathToDescriptors {
-		for _, linter := range linters {
+	for _, linter := range linters {
+		if protoSetLinter, ok := linter.(ProtoSetLinter); ok {
+			failures, err := checkProtoSet(protoSetLinter, dirPathToDescriptors, ignoreIDToFilePaths)
+			if err != nil {
+				return nil, err
+			}
+			allFailures = append(allFailures, failures...)
+			continue
+		}
+		for dirPath, descriptors := range dirPathToDescriptors {
 			failures, err := checkOne(linter, dirPath, descriptors, ignoreIDToFilePaths)
 			if err != nil {
 				return nil, err
@@ -474,6 +832,20 @@ func CheckMultiple(linters []Linter, dirPathToDescriptors map[string][]*FileDesc
 	return allFailures, nil
 }
 
+// SetSeverities sets the severity of each of the failures according to the
+// Linter that produced it and the overrides in the LintConfig.
+func SetSeverities(config settings.LintConfig, linters []Linter, failures []*text.Failure) {
+	idToSeverity := make(map[string]string, len(linters))
+	for _, linter := range linters {
+		idToSeverity[linter.ID()] = GetSeverity(config, linter)
+	}
+	for _, failure := range failures {
+		if severity, ok := idToSeverity[failure.LintID]; ok {
+			failure.Severity = severity
+		}
+	}
+}
+
 func checkOne(linter Linter, dirPath string, descriptors []*FileDescriptor, ignoreIDToFilePaths map[string][]string) ([]*text.Failure, error) {
 	filteredDescriptors, err := filterIgnores(linter, descriptors, ignoreIDToFilePaths)
 	if err != nil {
@@ -482,6 +854,18 @@ func checkOne(linter Linter, dirPath string, descriptors []*FileDescriptor, igno
 	return linter.Check(dirPath, filteredDescriptors)
 }
 
+func checkProtoSet(linter ProtoSetLinter, dirPathToDescriptors map[string][]*FileDescriptor, ignoreIDToFilePaths map[string][]string) ([]*text.Failure, error) {
+	filteredDirPathToDescriptors := make(map[string][]*FileDescriptor, len(dirPathToDescriptors))
+	for dirPath, descriptors := range dirPathToDescriptors {
+		filteredDescriptors, err := filterIgnores(linter, descriptors, ignoreIDToFilePaths)
+		if err != nil {
+			return nil, err
+		}
+		filteredDirPathToDescriptors[dirPath] = filteredDescriptors
+	}
+	return linter.CheckProtoSet(filteredDirPathToDescriptors)
+}
+
 func filterIgnores(linter Linter, descriptors []*FileDescriptor, ignoreIDToFilePaths map[string][]string) ([]*FileDescriptor, error) {
 	var filteredDescriptors []*FileDescriptor
 	for _, descriptor := range descriptors {
@@ -524,6 +908,71 @@ func checkLintID(lintID string) error {
 	return nil
 }
 
+type defaultSeverityLinter struct {
+	Linter
+
+	defaultSeverity string
+}
+
+func (l *defaultSeverityLinter) DefaultSeverity() string {
+	return l.defaultSeverity
+}
+
+func (l *defaultSeverityLinter) needsFileDescriptorSets() bool {
+	return needsFileDescriptorSets(l.Linter)
+}
+
+// fileDescriptorSetsLinter is a Linter that may need the compiled
+// FileDescriptorProtos of the files.
+type fileDescriptorSetsLinter interface {
+	needsFileDescriptorSets() bool
+}
+
+func needsFileDescriptorSets(linter Linter) bool {
+	fileDescriptorSetsLinter, ok := linter.(fileDescriptorSetsLinter)
+	return ok && fileDescriptorSetsLinter.needsFileDescriptorSets()
+}
+
+// fileDescriptorProtoMap finds the compiled FileDescriptorProtos of files.
+//
+// The names of FileDescriptorProtos are relative to the include paths
+// given to protoc, so files are matched by the longest suffix of their
+// path that is the name of a FileDescriptorProto.
+type fileDescriptorProtoMap struct {
+	nameToFileDescriptorProto map[string]*descriptor.FileDescriptorProto
+}
+
+// newFileDescriptorProtoMap returns a new fileDescriptorProtoMap, or nil
+// if there are no FileDescriptorSets.
+func newFileDescriptorProtoMap(fileDescriptorSets []*descriptor.FileDescriptorSet) *fileDescriptorProtoMap {
+	if len(fileDescriptorSets) == 0 {
+		return nil
+	}
+	nameToFileDescriptorProto := make(map[string]*descriptor.FileDescriptorProto)
+	for _, fileDescriptorSet := range fileDescriptorSets {
+		for _, fileDescriptorProto := range fileDescriptorSet.GetFile() {
+			nameToFileDescriptorProto[fileDescriptorProto.GetName()] = fileDescriptorProto
+		}
+	}
+	return &fileDescriptorProtoMap{nameToFileDescriptorProto: nameToFileDescriptorProto}
+}
+
+// get returns the FileDescriptorProto for the file at the given path.
+//
+// It is safe to call get on a nil fileDescriptorProtoMap.
+func (m *fileDescriptorProtoMap) get(path string) (*descriptor.FileDescriptorProto, bool) {
+	if m == nil {
+		return nil, false
+	}
+	parts := strings.Split(filepath.ToSlash(path), "/")
+	for i := range parts {
+		if fileDescriptorProto, ok := m.nameToFileDescriptorProto[strings.Join(parts[i:], "/")]; ok {
+			return fileDescriptorProto, true
+		}
+	}
+	return nil, false
+}
+
 func hasGolangStyleComment(comment *proto.Comment, name string) bool {
 	return comment != nil && len(comment.Lines) > 0 && strings.HasPrefix(comment.Lines[0], fmt.Sprintf(" %s ", name))
 }
@@ -21,13 +21,18 @@
 package lint
 
 import (
+	"strings"
+
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"github.com/uber/prototool/internal/file"
 	"github.com/uber/prototool/internal/text"
 	"go.uber.org/zap"
 )
 
 type runner struct {
-	logger *zap.Logger
+	logger             *zap.Logger
+	cachePath          string
+	fileDescriptorSets []*descriptor.FileDescriptorSet
 }
 
 func newRunner(options ...RunnerOption) *runner {
@@ -45,9 +50,163 @@ func (r *runner) Run(protoSet *file.ProtoSet) ([]*text.Failure, error) {
 	if err != nil {
 		return nil, err
 	}
-	dirPathToDescriptors, err := GetDirPathToDescriptors(protoSet)
+	if r.cachePath != "" {
+		return r.runCached(protoSet, linters)
+	}
+	dirPathToDescriptors, err := getDirPathToDescriptors(protoSet, newFileDescriptorProtoMap(r.fileDescriptorSets))
+	if err != nil {
+		return nil, err
+	}
+	failures, err := CheckMultiple(linters, dirPathToDescriptors, protoSet.Config.Lint.IgnoreIDToFilePaths)
 	if err != nil {
 		return nil, err
 	}
-	return CheckMultiple(linters, dirPathToDescriptors, protoSet.Config.Lint.IgnoreIDToFilePaths)
+	SetSeverities(protoSet.Config.Lint, linters, failures)
+	return failures, nil
+}
+
+func (r *runner) GetCachedFailures(protoSet *file.ProtoSet) ([]*text.Failure, bool, error) {
+	if r.cachePath == "" {
+		return nil, false, nil
+	}
+	linters, err := GetLinters(protoSet.Config.Lint)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, linter := range linters {
+		if _, ok := uncachedLinterIDs[linter.ID()]; ok {
+			return nil, false, nil
+		}
+	}
+	cache, err := newResultCache(r.cachePath, protoSet, linters)
+	if err != nil {
+		return nil, false, err
+	}
+	dirPathToKey, err := getCacheDirPathToKey(cache, protoSet)
+	if err != nil {
+		return nil, false, err
+	}
+	failures, ok := cache.get(cache.getProtoSetKey("all", dirPathToKey))
+	if ok {
+		r.logger.Debug("using cached lint failures for all files")
+	}
+	return failures, ok, nil
+}
+
+// runCached runs the linters, reusing the cached failures of the directory
+// linters for each unchanged directory, and the cached failures of the
+// ProtoSet linters if no directory changed.
+//
+// Only the files that need to be checked again are parsed.
+func (r *runner) runCached(protoSet *file.ProtoSet, linters []Linter) ([]*text.Failure, error) {
+	cache, err := newResultCache(r.cachePath, protoSet, linters)
+	if err != nil {
+		return nil, err
+	}
+	dirPathToKey, err := getCacheDirPathToKey(cache, protoSet)
+	if err != nil {
+		return nil, err
+	}
+	var dirLinters, protoSetLinters, uncachedLinters []Linter
+	for _, linter := range linters {
+		if _, ok := uncachedLinterIDs[linter.ID()]; ok {
+			uncachedLinters = append(uncachedLinters, linter)
+		} else if _, ok := linter.(ProtoSetLinter); ok || needsFileDescriptorSets(linter) {
+			// the descriptor linters resolve types across directories, so
+			// their failures can change when any directory changes
+			protoSetLinters = append(protoSetLinters, linter)
+		} else {
+			dirLinters = append(dirLinters, linter)
+		}
+	}
+	ignoreIDToFilePaths := protoSet.Config.Lint.IgnoreIDToFilePaths
+
+	var failures []*text.Failure
+	var uncachedDirPaths []string
+	for dirPath, key := range dirPathToKey {
+		if dirFailures, ok := cache.get(key); ok {
+			failures = append(failures, dirFailures...)
+		} else {
+			uncachedDirPaths = append(uncachedDirPaths, dirPath)
+		}
+	}
+	protoSetKey := cache.getProtoSetKey("protoset", dirPathToKey)
+	var protoSetFailures []*text.Failure
+	needProtoSetLinters := false
+	if len(protoSetLinters) > 0 {
+		var ok bool
+		protoSetFailures, ok = cache.get(protoSetKey)
+		needProtoSetLinters = !ok
+	}
+	r.logger.Sugar().Debugf("using cached lint failures for %d of %d directories", len(dirPathToKey)-len(uncachedDirPaths), len(dirPathToKey))
+
+	dirPathToDescriptors := make(map[string][]*FileDescriptor)
+	parseDirPaths := uncachedDirPaths
+	if needProtoSetLinters || len(uncachedLinters) > 0 {
+		parseDirPaths = make([]string, 0, len(dirPathToKey))
+		for dirPath := range dirPathToKey {
+			parseDirPaths = append(parseDirPaths, dirPath)
+		}
+	}
+	fileDescriptorProtos := newFileDescriptorProtoMap(r.fileDescriptorSets)
+	for _, dirPath := range parseDirPaths {
+		descriptors, err := getDescriptors(protoSet, protoSet.DirPathToFiles[dirPath], fileDescriptorProtos)
+		if err != nil {
+			return nil, err
+		}
+		dirPathToDescriptors[dirPath] = descriptors
+	}
+
+	for _, dirPath := range uncachedDirPaths {
+		dirFailures, err := CheckMultiple(dirLinters, map[string][]*FileDescriptor{dirPath: dirPathToDescriptors[dirPath]}, ignoreIDToFilePaths)
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.put(dirPathToKey[dirPath], dirFailures); err != nil {
+			return nil, err
+		}
+		failures = append(failures, dirFailures...)
+	}
+	if needProtoSetLinters {
+		protoSetFailures, err = CheckMultiple(protoSetLinters, dirPathToDescriptors, ignoreIDToFilePaths)
+		if err != nil {
+			return nil, err
+		}
+		if err := cache.put(protoSetKey, protoSetFailures); err != nil {
+			return nil, err
+		}
+	}
+	failures = append(failures, protoSetFailures...)
+	if len(uncachedLinters) > 0 {
+		uncachedFailures, err := CheckMultiple(uncachedLinters, dirPathToDescriptors, ignoreIDToFilePaths)
+		if err != nil {
+			return nil, err
+		}
+		failures = append(failures, uncachedFailures...)
+	}
+	SetSeverities(protoSet.Config.Lint, linters, failures)
+	if len(uncachedLinters) == 0 {
+		if err := cache.put(cache.getProtoSetKey("all", dirPathToKey), failures); err != nil {
+			return nil, err
+		}
+	}
+	return failures, nil
+}
+
+// getCacheDirPathToKey returns the cache key of each directory that
+// is linted.
+func getCacheDirPathToKey(cache *resultCache, protoSet *file.ProtoSet) (map[string]string, error) {
+	dirPathToKey := make(map[string]string, len(protoSet.DirPathToFiles))
+	for dirPath, protoFiles := range protoSet.DirPathToFiles {
+		// skip those files not under the directory
+		if !strings.HasPrefix(dirPath, protoSet.DirPath) {
+			continue
+		}
+		key, err := cache.getDirKey(protoFiles)
+		if err != nil {
+			return nil, err
+		}
+		dirPathToKey[dirPath] = key
+	}
+	return dirPathToKey, nil
 }
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package migrate migrates Protobuf files between syntax versions.
+package migrate
+
+import (
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/uber/prototool/internal/format"
+	"github.com/uber/prototool/internal/text"
+	"go.uber.org/zap"
+)
+
+const (
+	// Proto3BreakingChangeID is the ID of the failures for changes made when
+	// migrating to proto3 that can break wire compatibility or the behavior
+	// of existing code.
+	Proto3BreakingChangeID = "PROTO3_BREAKING_CHANGE"
+	// Proto3NotConvertedID is the ID of the failures for elements that
+	// cannot be converted to proto3 safely.
+	Proto3NotConvertedID = "PROTO3_NOT_CONVERTED"
+)
+
+// Migrator migrates Protobuf files.
+type Migrator interface {
+	// Proto3 migrates the proto2 file with the given data to proto3.
+	//
+	// The migrated file is returned with a failure with severity warning
+	// for each change that can break compatibility, and a failure with
+	// severity error for each element that cannot be converted safely, in
+	// which case the migrated file should not be used.
+	//
+	// Files that are already proto3 are returned unchanged.
+	Proto3(filename string, data []byte) ([]byte, []*text.Failure, error)
+}
+
+// MigratorOption is an option for a new Migrator.
+type MigratorOption func(*migrator)
+
+// MigratorWithLogger returns a MigratorOption that uses the given logger.
+//
+// The default is to use zap.NewNop().
+func MigratorWithLogger(logger *zap.Logger) MigratorOption {
+	return func(migrator *migrator) {
+		migrator.logger = logger
+	}
+}
+
+// MigratorWithTransformerOptions returns a MigratorOption that prints the
+// migrated files with a format Transformer with the given options, such
+// as the configured indentation.
+func MigratorWithTransformerOptions(transformerOptions ...format.TransformerOption) MigratorOption {
+	return func(migrator *migrator) {
+		migrator.transformerOptions = append(migrator.transformerOptions, transformerOptions...)
+	}
+}
+
+// MigratorWithFileDescriptorSets returns a MigratorOption that resolves the
+// types of fields with the given compiled FileDescriptorSets, which must
+// include the imports of the migrated files.
+//
+// This finds the fields of enums declared in imported files, which lose
+// their presence like the fields of enums declared in the migrated file,
+// and the fields of enums declared in proto2 files, which cannot be used
+// in proto3 unless their files are migrated as well.
+// The default is to only know the enums declared in the migrated file.
+func MigratorWithFileDescriptorSets(fileDescriptorSets []*descriptor.FileDescriptorSet) MigratorOption {
+	return func(migrator *migrator) {
+		migrator.fileDescriptorSets = fileDescriptorSets
+	}
+}
+
+// MigratorWithMigratedFilePaths returns a MigratorOption that marks the
+// files at the given paths as migrated to proto3 together, so that the
+// enums declared in them can be used by each other.
+//
+// This only has an effect with MigratorWithFileDescriptorSets.
+func MigratorWithMigratedFilePaths(filePaths ...string) MigratorOption {
+	return func(migrator *migrator) {
+		migrator.migratedFilePaths = append(migrator.migratedFilePaths, filePaths...)
+	}
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(options ...MigratorOption) Migrator {
+	return newMigrator(options...)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"github.com/uber/prototool/internal/format"
+	"github.com/uber/prototool/internal/text"
+	"go.uber.org/zap"
+)
+
+type migrator struct {
+	logger             *zap.Logger
+	transformerOptions []format.TransformerOption
+	fileDescriptorSets []*descriptor.FileDescriptorSet
+	migratedFilePaths  []string
+
+	// nil if there are no FileDescriptorSets
+	enumTypes *enumTypes
+}
+
+func newMigrator(options ...MigratorOption) *migrator {
+	migrator := &migrator{
+		logger: zap.NewNop(),
+	}
+	for _, option := range options {
+		option(migrator)
+	}
+	migrator.enumTypes = newEnumTypes(migrator.fileDescriptorSets)
+	return migrator
+}
+
+func (m *migrator) Proto3(filename string, data []byte) ([]byte, []*text.Failure, error) {
+	isProto3 := false
+	modifier := func(descriptor *proto.Proto) []*text.Failure {
+		if getSyntax(descriptor) == "proto3" {
+			isProto3 = true
+			return nil
+		}
+		isMigratedFile := func(name string) bool {
+			return isFileWithName(filename, name) || m.isMigratedFile(name)
+		}
+		return migrateProto3(descriptor, m.enumTypes, isMigratedFile)
+	}
+	transformerOptions := []format.TransformerOption{format.TransformerWithLogger(m.logger)}
+	transformerOptions = append(transformerOptions, m.transformerOptions...)
+	transformerOptions = append(transformerOptions, format.TransformerWithModifier(modifier))
+	migrated, failures, err := format.NewTransformer(transformerOptions...).Transform(filename, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if isProto3 {
+		m.logger.Debug("file is already proto3", zap.String("file", filename))
+		return data, nil, nil
+	}
+	return migrated, failures, nil
+}
+
+// isMigratedFile returns true if the file with the given name, which is
+// relative to the include paths given to protoc, is migrated to proto3.
+func (m *migrator) isMigratedFile(name string) bool {
+	for _, filePath := range m.migratedFilePaths {
+		if isFileWithName(filePath, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func getSyntax(descriptor *proto.Proto) string {
+	for _, element := range descriptor.Elements {
+		if syntax, ok := element.(*proto.Syntax); ok {
+			return syntax.Value
+		}
+	}
+	// files without a syntax are proto2
+	return "proto2"
+}
+
+// enumTypes are the enum types of fields resolved from compiled
+// FileDescriptorSets.
+type enumTypes struct {
+	// the full names of fields and extensions to the full names of their
+	// enum types, or of the enum types of their values for map fields
+	fieldToEnum map[string]string
+	// the full names of the enums declared in proto2 files to the names of
+	// their files
+	proto2EnumToFile map[string]string
+}
+
+func newEnumTypes(fileDescriptorSets []*descriptor.FileDescriptorSet) *enumTypes {
+	if len(fileDescriptorSets) == 0 {
+		return nil
+	}
+	e := &enumTypes{
+		fieldToEnum:      make(map[string]string),
+		proto2EnumToFile: make(map[string]string),
+	}
+	messages := make(map[string]*descriptor.DescriptorProto)
+	for _, fileDescriptorSet := range fileDescriptorSets {
+		for _, fileDescriptorProto := range fileDescriptorSet.GetFile() {
+			addMessages(messages, fileDescriptorProto.GetPackage(), fileDescriptorProto.GetMessageType())
+		}
+	}
+	for _, fileDescriptorSet := range fileDescriptorSets {
+		for _, fileDescriptorProto := range fileDescriptorSet.GetFile() {
+			pkg := fileDescriptorProto.GetPackage()
+			if fileDescriptorProto.GetSyntax() != "proto3" {
+				e.addProto2Enums(fileDescriptorProto.GetName(), pkg, fileDescriptorProto.GetEnumType(), fileDescriptorProto.GetMessageType())
+			}
+			e.addFields(messages, pkg, fileDescriptorProto.GetExtension())
+			e.addMessageFields(messages, pkg, fileDescriptorProto.GetMessageType())
+		}
+	}
+	return e
+}
+
+func (e *enumTypes) addProto2Enums(fileName string, scope string, enums []*descriptor.EnumDescriptorProto, messages []*descriptor.DescriptorProto) {
+	for _, enum := range enums {
+		e.proto2EnumToFile[joinFullName(scope, enum.GetName())] = fileName
+	}
+	for _, message := range messages {
+		messageScope := joinFullName(scope, message.GetName())
+		e.addProto2Enums(fileName, messageScope, message.GetEnumType(), message.GetNestedType())
+	}
+}
+
+func (e *enumTypes) addMessageFields(messages map[string]*descriptor.DescriptorProto, scope string, messageTypes []*descriptor.DescriptorProto) {
+	for _, message := range messageTypes {
+		messageScope := joinFullName(scope, message.GetName())
+		e.addFields(messages, messageScope, message.GetField())
+		e.addFields(messages, messageScope, message.GetExtension())
+		e.addMessageFields(messages, messageScope, message.GetNestedType())
+	}
+}
+
+func (e *enumTypes) addFields(messages map[string]*descriptor.DescriptorProto, scope string, fields []*descriptor.FieldDescriptorProto) {
+	for _, field := range fields {
+		if enumName, ok := getFieldEnumName(messages, field); ok {
+			e.fieldToEnum[joinFullName(scope, field.GetName())] = enumName
+		}
+	}
+}
+
+// getFieldEnumName returns the full name of the enum type of the field, or
+// of the enum type of its values if the field is a map field.
+func getFieldEnumName(messages map[string]*descriptor.DescriptorProto, field *descriptor.FieldDescriptorProto) (string, bool) {
+	switch field.GetType() {
+	case descriptor.FieldDescriptorProto_TYPE_ENUM:
+		return strings.TrimPrefix(field.GetTypeName(), "."), true
+	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
+		message, ok := messages[strings.TrimPrefix(field.GetTypeName(), ".")]
+		if !ok || !message.GetOptions().GetMapEntry() {
+			return "", false
+		}
+		for _, mapField := range message.GetField() {
+			if mapField.GetName() == "value" {
+				return getFieldEnumName(messages, mapField)
+			}
+		}
+	}
+	return "", false
+}
+
+func addMessages(messages map[string]*descriptor.DescriptorProto, scope string, messageTypes []*descriptor.DescriptorProto) {
+	for _, message := range messageTypes {
+		fullName := joinFullName(scope, message.GetName())
+		messages[fullName] = message
+		addMessages(messages, fullName, message.GetNestedType())
+	}
+}
+
+// isFileWithName returns true if the file at the given path has the given
+// name, which is relative to the include paths given to protoc.
+func isFileWithName(filePath string, name string) bool {
+	filePath = filepath.ToSlash(filePath)
+	return filePath == name || strings.HasSuffix(filePath, "/"+name)
+}
+
+func joinFullName(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
@@ -0,0 +1,337 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migrate
+
+import (
+	"fmt"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/strs"
+	"github.com/uber/prototool/internal/text"
+)
+
+var scalarTypes = map[string]struct{}{
+	"bool":     struct{}{},
+	"bytes":    struct{}{},
+	"double":   struct{}{},
+	"fixed32":  struct{}{},
+	"fixed64":  struct{}{},
+	"float":    struct{}{},
+	"int32":    struct{}{},
+	"int64":    struct{}{},
+	"sfixed32": struct{}{},
+	"sfixed64": struct{}{},
+	"sint32":   struct{}{},
+	"sint64":   struct{}{},
+	"string":   struct{}{},
+	"uint32":   struct{}{},
+	"uint64":   struct{}{},
+}
+
+// proto3Migration migrates a parsed proto2 file to proto3.
+type proto3Migration struct {
+	// the names of the enums declared in the file, without their scopes,
+	// as fields of enum types lose their presence like scalar fields
+	//
+	// only used if enumTypes is nil
+	enumNames map[string]struct{}
+	// nil if the types of fields are not resolved
+	enumTypes      *enumTypes
+	isMigratedFile func(string) bool
+	failures       []*text.Failure
+}
+
+// migrateProto3 migrates the parsed proto2 file to proto3, and returns
+// the failures for the breaking changes and elements that cannot be
+// converted.
+//
+// If enumTypes is not nil, fields of enums declared in files for which
+// isMigratedFile returns false and that are proto2 cannot be converted.
+func migrateProto3(descriptor *proto.Proto, enumTypes *enumTypes, isMigratedFile func(string) bool) []*text.Failure {
+	m := &proto3Migration{
+		enumNames:      make(map[string]struct{}),
+		enumTypes:      enumTypes,
+		isMigratedFile: isMigratedFile,
+	}
+	addEnumNames(m.enumNames, descriptor.Elements)
+	setProto3Syntax(descriptor)
+	descriptor.Elements = m.migrateElements(descriptor.Elements, getPackage(descriptor), nil)
+	return m.failures
+}
+
+func getPackage(descriptor *proto.Proto) string {
+	for _, element := range descriptor.Elements {
+		if pkg, ok := element.(*proto.Package); ok {
+			return pkg.Name
+		}
+	}
+	return ""
+}
+
+func setProto3Syntax(descriptor *proto.Proto) {
+	for _, element := range descriptor.Elements {
+		if syntax, ok := element.(*proto.Syntax); ok {
+			syntax.Value = "proto3"
+			return
+		}
+	}
+	// the syntax goes after the leading comments, which are the file header
+	i := 0
+	for i < len(descriptor.Elements) {
+		if _, ok := descriptor.Elements[i].(*proto.Comment); !ok {
+			break
+		}
+		i++
+	}
+	syntax := &proto.Syntax{Value: "proto3", Parent: descriptor}
+	descriptor.Elements = append(descriptor.Elements[:i], append([]proto.Visitee{syntax}, descriptor.Elements[i:]...)...)
+}
+
+func addEnumNames(enumNames map[string]struct{}, elements []proto.Visitee) {
+	for _, element := range elements {
+		switch t := element.(type) {
+		case *proto.Enum:
+			enumNames[t.Name] = struct{}{}
+		case *proto.Message:
+			addEnumNames(enumNames, t.Elements)
+		case *proto.Group:
+			addEnumNames(enumNames, t.Elements)
+		}
+	}
+}
+
+// migrateElements migrates the elements of a file or a message body, where
+// scope is the full name of the enclosing message or the package, and
+// nestedNames are the upper snake case names of the enclosing messages, and
+// returns the new elements.
+func (m *proto3Migration) migrateElements(elements []proto.Visitee, scope string, nestedNames []string) []proto.Visitee {
+	var result []proto.Visitee
+	for _, element := range elements {
+		switch t := element.(type) {
+		case *proto.Message:
+			if t.IsExtend {
+				m.migrateExtend(t, scope)
+			} else {
+				t.Elements = m.migrateElements(t.Elements, joinFullName(scope, t.Name), appendName(nestedNames, t.Name))
+			}
+		case *proto.Enum:
+			m.migrateEnum(t, nestedNames)
+		case *proto.NormalField:
+			m.migrateNormalField(t, scope)
+		case *proto.MapField:
+			m.checkEnum(t.Field, scope)
+		case *proto.Oneof:
+			for i, child := range t.Elements {
+				switch c := child.(type) {
+				case *proto.OneOfField:
+					m.checkEnum(c.Field, scope)
+					m.removeDefault(c.Field)
+				case *proto.Group:
+					// the message of a group in a oneof goes in the enclosing message
+					message, field := m.convertGroup(c, scope, nestedNames)
+					result = append(result, message)
+					t.Elements[i] = &proto.OneOfField{Field: field.Field}
+				}
+			}
+		case *proto.Group:
+			message, field := m.convertGroup(t, scope, nestedNames)
+			result = append(result, message)
+			element = field
+		case *proto.Extensions:
+			m.addNotConverted(t.Position, "Extension ranges are not allowed in proto3.")
+		case *proto.Option:
+			if t.Name == "message_set_wire_format" && t.Constant.Source == "true" {
+				m.addNotConverted(t.Position, "The message set wire format is not supported in proto3.")
+			}
+		}
+		result = append(result, element)
+	}
+	return result
+}
+
+func (m *proto3Migration) migrateExtend(message *proto.Message, scope string) {
+	name := strings.TrimPrefix(message.Name, ".")
+	if !strings.HasPrefix(name, "google.protobuf.") || !strings.HasSuffix(name, "Options") {
+		m.addNotConverted(message.Position, "Extensions of %q are not allowed in proto3, only extensions of the descriptor options for custom options are.", message.Name)
+	}
+	for _, element := range message.Elements {
+		switch t := element.(type) {
+		case *proto.NormalField:
+			// extensions always have presence, so only the label changes
+			t.Optional = false
+			m.checkEnum(t.Field, scope)
+			m.removeDefault(t.Field)
+		case *proto.Group:
+			m.addNotConverted(t.Position, "Group extensions are not allowed in proto3.")
+		}
+	}
+}
+
+func (m *proto3Migration) migrateNormalField(field *proto.NormalField, scope string) {
+	m.checkEnum(field.Field, scope)
+	if field.Required {
+		field.Required = false
+		m.addBreakingChange(field.Position, "Field %q is no longer required, so messages without it are no longer rejected when parsed.", field.Name)
+	} else if field.Optional {
+		field.Optional = false
+		if m.isScalarOrEnum(field.Field, scope) {
+			m.addBreakingChange(field.Position, "Field %q no longer has presence, so a field that is set to its default value cannot be distinguished from an unset field.", field.Name)
+		}
+	}
+	m.removeDefault(field.Field)
+}
+
+func (m *proto3Migration) removeDefault(field *proto.Field) {
+	var options []*proto.Option
+	for _, option := range field.Options {
+		if option.Name == "default" {
+			m.addBreakingChange(option.Position, "Default value %s of field %q is removed, so the field defaults to the zero value of its type.", option.Constant.SourceRepresentation(), field.Name)
+			continue
+		}
+		options = append(options, option)
+	}
+	field.Options = options
+}
+
+// convertGroup converts the group to a nested message and a field of that
+// message, which is how protoc represents groups in descriptors.
+func (m *proto3Migration) convertGroup(group *proto.Group, scope string, nestedNames []string) (*proto.Message, *proto.NormalField) {
+	message := &proto.Message{
+		Position: group.Position,
+		Comment:  group.Comment,
+		Name:     group.Name,
+		Parent:   group.Parent,
+	}
+	message.Elements = m.migrateElements(group.Elements, joinFullName(scope, group.Name), appendName(nestedNames, group.Name))
+	field := &proto.NormalField{
+		Field: &proto.Field{
+			Position: group.Position,
+			Name:     strings.ToLower(group.Name),
+			Type:     group.Name,
+			Sequence: group.Sequence,
+			Parent:   group.Parent,
+		},
+		Repeated: group.Repeated,
+	}
+	m.addBreakingChange(group.Position, "Group %q is converted to the nested message %q and field %q, which changes the wire format of the field from a group to a message.", group.Name, message.Name, field.Name)
+	if group.Required {
+		m.addBreakingChange(group.Position, "Field %q is no longer required, so messages without it are no longer rejected when parsed.", field.Name)
+	}
+	return message, field
+}
+
+// migrateEnum makes sure that the first value of the enum is zero, as
+// required by proto3, by moving the zero value to be first, or adding one
+// named as expected by ENUM_ZERO_VALUES_INVALID.
+func (m *proto3Migration) migrateEnum(enum *proto.Enum, nestedNames []string) {
+	firstIndex := -1
+	zeroIndex := -1
+	names := make(map[string]struct{})
+	for i, element := range enum.Elements {
+		if enumField, ok := element.(*proto.EnumField); ok {
+			names[enumField.Name] = struct{}{}
+			if firstIndex < 0 {
+				firstIndex = i
+			}
+			if zeroIndex < 0 && enumField.Integer == 0 {
+				zeroIndex = i
+			}
+		}
+	}
+	if firstIndex >= 0 && firstIndex == zeroIndex {
+		return
+	}
+	// in proto2, the default of a field is the first value of its enum
+	// and in proto3, it is the zero value
+	var defaultChange string
+	if firstIndex >= 0 {
+		defaultChange = fmt.Sprintf(", which changes the default of fields of this enum from %q", enum.Elements[firstIndex].(*proto.EnumField).Name)
+	}
+	if zeroIndex >= 0 {
+		zeroField := enum.Elements[zeroIndex]
+		copy(enum.Elements[firstIndex+1:zeroIndex+1], enum.Elements[firstIndex:zeroIndex])
+		enum.Elements[firstIndex] = zeroField
+		m.addBreakingChange(enum.Position, "Zero value %q of enum %q is moved to be the first value%s.", zeroField.(*proto.EnumField).Name, enum.Name, defaultChange)
+		return
+	}
+	name := strings.Join(appendName(nestedNames, enum.Name), "_") + "_INVALID"
+	if _, ok := names[name]; ok {
+		m.addNotConverted(enum.Position, "Enum %q has no zero value, and the name %q for a new zero value is already used.", enum.Name, name)
+		return
+	}
+	if firstIndex < 0 {
+		firstIndex = len(enum.Elements)
+	}
+	zeroField := &proto.EnumField{Name: name, Integer: 0, Parent: enum}
+	enum.Elements = append(enum.Elements[:firstIndex], append([]proto.Visitee{zeroField}, enum.Elements[firstIndex:]...)...)
+	m.addBreakingChange(enum.Position, "Zero value %q is added to enum %q%s.", name, enum.Name, defaultChange)
+}
+
+// checkEnum adds a failure if the field, or the values of the field if it
+// is a map field, are of an enum declared in a proto2 file that is not
+// migrated, as proto3 files can only use proto3 enums.
+func (m *proto3Migration) checkEnum(field *proto.Field, scope string) {
+	if m.enumTypes == nil {
+		return
+	}
+	enumName, ok := m.enumTypes.fieldToEnum[joinFullName(scope, field.Name)]
+	if !ok {
+		return
+	}
+	if fileName, ok := m.enumTypes.proto2EnumToFile[enumName]; ok && !m.isMigratedFile(fileName) {
+		m.addNotConverted(field.Position, "Field %q uses the enum %q of the proto2 file %q, which cannot be used in proto3 unless that file is migrated as well.", field.Name, enumName, fileName)
+	}
+}
+
+func (m *proto3Migration) isScalarOrEnum(field *proto.Field, scope string) bool {
+	if _, ok := scalarTypes[field.Type]; ok {
+		return true
+	}
+	if m.enumTypes != nil {
+		_, ok := m.enumTypes.fieldToEnum[joinFullName(scope, field.Name)]
+		return ok
+	}
+	typeName := field.Type
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		typeName = typeName[i+1:]
+	}
+	_, ok := m.enumNames[typeName]
+	return ok
+}
+
+func (m *proto3Migration) addBreakingChange(position scanner.Position, format string, args ...interface{}) {
+	failure := text.NewFailuref(position, Proto3BreakingChangeID, format, args...)
+	failure.Severity = text.SeverityWarning
+	m.failures = append(m.failures, failure)
+}
+
+func (m *proto3Migration) addNotConverted(position scanner.Position, format string, args ...interface{}) {
+	failure := text.NewFailuref(position, Proto3NotConvertedID, format, args...)
+	failure.Severity = text.SeverityError
+	m.failures = append(m.failures, failure)
+}
+
+func appendName(nestedNames []string, name string) []string {
+	// copy so that sibling scopes do not share the backing array
+	return append(append([]string(nil), nestedNames...), strs.ToUpperSnakeCase(name))
+}
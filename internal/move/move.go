@@ -0,0 +1,76 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package move moves Protobuf files between directories and packages.
+package move
+
+import (
+	"github.com/uber/prototool/internal/file"
+	"go.uber.org/zap"
+)
+
+// Move is the move of a file to a new path and package.
+type Move struct {
+	// The path of the file.
+	// Must be absolute.
+	// Must be cleaned.
+	Path string
+	// The path to move the file to, which can be the same as Path.
+	// Must be absolute.
+	// Must be cleaned.
+	NewPath string
+	// The package to move the file to.
+	// If empty, the package of the file is not changed.
+	NewPackage string
+}
+
+// Mover moves Protobuf files.
+type Mover interface {
+	// Move applies the moves to the files in the ProtoSet.
+	//
+	// The package declarations of the moved files, the import paths of
+	// the moved files and the references to the types and extensions in
+	// the moved files, including the names of custom options, are updated
+	// across all files in the ProtoSet. Import paths are
+	// relative to the directory of the config, or the working directory
+	// if there is no config.
+	//
+	// The contents of the files that change are returned by their new
+	// path. This always includes the files that are moved to a new path.
+	// Nothing is written to disk.
+	Move(protoSet *file.ProtoSet, moves ...*Move) (map[string][]byte, error)
+}
+
+// MoverOption is an option for a new Mover.
+type MoverOption func(*mover)
+
+// MoverWithLogger returns a MoverOption that uses the given logger.
+//
+// The default is to use zap.NewNop().
+func MoverWithLogger(logger *zap.Logger) MoverOption {
+	return func(mover *mover) {
+		mover.logger = logger
+	}
+}
+
+// NewMover returns a new Mover.
+func NewMover(options ...MoverOption) Mover {
+	return newMover(options...)
+}
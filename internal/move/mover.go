@@ -0,0 +1,589 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package move
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/scanner"
+
+	"github.com/emicklei/proto"
+	"github.com/uber/prototool/internal/file"
+	"go.uber.org/zap"
+)
+
+type mover struct {
+	logger *zap.Logger
+}
+
+func newMover(options ...MoverOption) *mover {
+	mover := &mover{
+		logger: zap.NewNop(),
+	}
+	for _, option := range options {
+		option(mover)
+	}
+	return mover
+}
+
+func (m *mover) Move(protoSet *file.ProtoSet, moves ...*Move) (map[string][]byte, error) {
+	rootDirPath := protoSet.Config.DirPath
+	if rootDirPath == "" {
+		rootDirPath = protoSet.WorkDirPath
+	}
+	files, err := getMoveFiles(protoSet, rootDirPath)
+	if err != nil {
+		return nil, err
+	}
+	pathToFile := make(map[string]*moveFile, len(files))
+	for _, protoFile := range files {
+		pathToFile[protoFile.path] = protoFile
+	}
+	for _, move := range moves {
+		protoFile, ok := pathToFile[move.Path]
+		if !ok {
+			return nil, fmt.Errorf("%s is not in the Protobuf files of %s", move.Path, rootDirPath)
+		}
+		newImportPath, err := getImportPath(rootDirPath, move.NewPath)
+		if err != nil {
+			return nil, err
+		}
+		protoFile.newPath = move.NewPath
+		protoFile.newImportPath = newImportPath
+		if move.NewPackage != "" {
+			protoFile.newPkg = move.NewPackage
+		}
+		if protoFile.newPath != protoFile.path || protoFile.newPkg != protoFile.pkg {
+			m.logger.Debug("moving", zap.String("protoFile", protoFile.displayPath), zap.String("path", protoFile.newPath), zap.String("package", protoFile.newPkg))
+		}
+	}
+	newPathToFile := make(map[string]*moveFile, len(files))
+	for _, protoFile := range files {
+		if other, ok := newPathToFile[protoFile.newPath]; ok {
+			return nil, fmt.Errorf("cannot move %s and %s to the same path %s", other.displayPath, protoFile.displayPath, protoFile.newPath)
+		}
+		newPathToFile[protoFile.newPath] = protoFile
+	}
+
+	state := newMoveState()
+	for _, protoFile := range files {
+		if err := state.addFile(protoFile); err != nil {
+			return nil, err
+		}
+	}
+	fileToEdits, err := state.getEdits(files)
+	if err != nil {
+		return nil, err
+	}
+	newPathToData := make(map[string][]byte)
+	for _, protoFile := range files {
+		edits, ok := fileToEdits[protoFile]
+		if !ok && protoFile.newPath == protoFile.path {
+			continue
+		}
+		data, err := applyEdits(protoFile.data, edits)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", protoFile.displayPath, err)
+		}
+		newPathToData[protoFile.newPath] = []byte(data)
+	}
+	return newPathToData, nil
+}
+
+// moveFile is a file in the ProtoSet, before and after moving.
+type moveFile struct {
+	path        string
+	displayPath string
+	data        string
+	descriptor  *proto.Proto
+	// the path of the file relative to the root directory, as used in imports
+	importPath string
+	pkg        string
+
+	newPath       string
+	newImportPath string
+	newPkg        string
+}
+
+// getMoveFiles parses all files in the ProtoSet, sorted by path.
+func getMoveFiles(protoSet *file.ProtoSet, rootDirPath string) ([]*moveFile, error) {
+	var files []*moveFile
+	for _, protoFiles := range protoSet.DirPathToFiles {
+		for _, protoFile := range protoFiles {
+			data, err := ioutil.ReadFile(protoFile.Path)
+			if err != nil {
+				return nil, err
+			}
+			parser := proto.NewParser(strings.NewReader(string(data)))
+			parser.Filename(protoFile.DisplayPath)
+			descriptor, err := parser.Parse()
+			if err != nil {
+				return nil, err
+			}
+			importPath, err := getImportPath(rootDirPath, protoFile.Path)
+			if err != nil {
+				return nil, err
+			}
+			pkg := getPackage(descriptor)
+			files = append(files, &moveFile{
+				path:          protoFile.Path,
+				displayPath:   protoFile.DisplayPath,
+				data:          string(data),
+				descriptor:    descriptor,
+				importPath:    importPath,
+				pkg:           pkg,
+				newPath:       protoFile.Path,
+				newImportPath: importPath,
+				newPkg:        pkg,
+			})
+		}
+	}
+	sort.Slice(files, func(i int, j int) bool { return files[i].path < files[j].path })
+	return files, nil
+}
+
+func getImportPath(rootDirPath string, path string) (string, error) {
+	rel, err := filepath.Rel(rootDirPath, path)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is not in %s", path, rootDirPath)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func getPackage(descriptor *proto.Proto) string {
+	for _, element := range descriptor.Elements {
+		if pkg, ok := element.(*proto.Package); ok {
+			return pkg.Name
+		}
+	}
+	return ""
+}
+
+// moveReference is a reference to a type, or to an extension in the name
+// of a custom option.
+type moveReference struct {
+	protoFile *moveFile
+	position  scanner.Position
+	// the keywords, names or punctuation that precede the reference in
+	// the declaration, in order
+	prefixes []string
+	// the names of the messages the reference is nested in
+	nestedNames []string
+	value       string
+}
+
+type moveState struct {
+	// the types, extensions and packages before moving
+	symbols *moveSymbols
+	// the types, extensions and packages after moving
+	newSymbols            *moveSymbols
+	fullNameToNewFullName map[string]string
+	newFullNameToFile     map[string]*moveFile
+	references            []*moveReference
+}
+
+func newMoveState() *moveState {
+	return &moveState{
+		symbols:               newMoveSymbols(),
+		newSymbols:            newMoveSymbols(),
+		fullNameToNewFullName: make(map[string]string),
+		newFullNameToFile:     make(map[string]*moveFile),
+	}
+}
+
+func (s *moveState) addFile(protoFile *moveFile) error {
+	s.symbols.addPackage(protoFile.pkg)
+	s.newSymbols.addPackage(protoFile.newPkg)
+	return s.addElements(protoFile, nil, protoFile.descriptor.Elements)
+}
+
+func (s *moveState) addElements(protoFile *moveFile, nestedNames []string, elements []proto.Visitee) error {
+	for _, element := range elements {
+		switch e := element.(type) {
+		case *proto.Message:
+			if e.IsExtend {
+				s.addReference(protoFile, e.Position, []string{"extend"}, nestedNames, e.Name)
+				// the fields of extend blocks are extensions in the enclosing scope
+				for _, child := range e.Elements {
+					switch c := child.(type) {
+					case *proto.NormalField:
+						if err := s.addSymbol(protoFile, nestedNames, c.Name); err != nil {
+							return err
+						}
+					case *proto.Group:
+						// the extension of a group is named like the field of a group
+						if err := s.addSymbol(protoFile, nestedNames, strings.ToLower(c.Name)); err != nil {
+							return err
+						}
+					}
+				}
+				if err := s.addElements(protoFile, nestedNames, e.Elements); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := s.addSymbol(protoFile, nestedNames, e.Name); err != nil {
+				return err
+			}
+			if err := s.addElements(protoFile, appendName(nestedNames, e.Name), e.Elements); err != nil {
+				return err
+			}
+		case *proto.Group:
+			if err := s.addSymbol(protoFile, nestedNames, e.Name); err != nil {
+				return err
+			}
+			if err := s.addElements(protoFile, appendName(nestedNames, e.Name), e.Elements); err != nil {
+				return err
+			}
+		case *proto.Enum:
+			if err := s.addSymbol(protoFile, nestedNames, e.Name); err != nil {
+				return err
+			}
+			for _, child := range e.Elements {
+				switch c := child.(type) {
+				case *proto.Option:
+					s.addOptionReference(protoFile, nestedNames, c)
+				case *proto.EnumField:
+					for _, enumFieldChild := range c.Elements {
+						if option, ok := enumFieldChild.(*proto.Option); ok {
+							s.addOptionReference(protoFile, nestedNames, option)
+						}
+					}
+				}
+			}
+		case *proto.Service:
+			for _, child := range e.Elements {
+				switch c := child.(type) {
+				case *proto.Option:
+					s.addOptionReference(protoFile, nestedNames, c)
+				case *proto.RPC:
+					s.addReference(protoFile, c.Position, []string{"rpc", c.Name, "("}, nestedNames, c.RequestType)
+					s.addReference(protoFile, c.Position, []string{"returns", "("}, nestedNames, c.ReturnsType)
+					for _, rpcChild := range c.Elements {
+						if option, ok := rpcChild.(*proto.Option); ok {
+							s.addOptionReference(protoFile, nestedNames, option)
+						}
+					}
+				}
+			}
+		case *proto.NormalField:
+			s.addReference(protoFile, e.Position, nil, nestedNames, e.Type)
+			s.addOptionReferences(protoFile, nestedNames, e.Options)
+		case *proto.OneOfField:
+			s.addReference(protoFile, e.Position, nil, nestedNames, e.Type)
+			s.addOptionReferences(protoFile, nestedNames, e.Options)
+		case *proto.MapField:
+			s.addReference(protoFile, e.Position, []string{","}, nestedNames, e.Type)
+			s.addOptionReferences(protoFile, nestedNames, e.Options)
+		case *proto.Oneof:
+			if err := s.addElements(protoFile, nestedNames, e.Elements); err != nil {
+				return err
+			}
+		case *proto.Option:
+			// options of the file, a message or a oneof
+			s.addOptionReference(protoFile, nestedNames, e)
+		}
+	}
+	return nil
+}
+
+// addSymbol adds the declaration of a type or an extension.
+func (s *moveState) addSymbol(protoFile *moveFile, nestedNames []string, name string) error {
+	fullName := joinFullName(protoFile.pkg, appendName(nestedNames, name)...)
+	newFullName := joinFullName(protoFile.newPkg, appendName(nestedNames, name)...)
+	if other, ok := s.newFullNameToFile[newFullName]; ok {
+		return fmt.Errorf("cannot move as %s in %s would conflict with %s in %s", fullName, protoFile.displayPath, newFullName, other.displayPath)
+	}
+	s.newFullNameToFile[newFullName] = protoFile
+	s.fullNameToNewFullName[fullName] = newFullName
+	s.symbols.addSymbol(fullName)
+	s.newSymbols.addSymbol(newFullName)
+	return nil
+}
+
+func (s *moveState) addOptionReferences(protoFile *moveFile, nestedNames []string, options []*proto.Option) {
+	for _, option := range options {
+		s.addOptionReference(protoFile, nestedNames, option)
+	}
+}
+
+// addOptionReference adds the reference to the extension in the name of
+// a custom option, such as a.v1.label in (a.v1.label).sub.
+func (s *moveState) addOptionReference(protoFile *moveFile, nestedNames []string, option *proto.Option) {
+	if !strings.HasPrefix(option.Name, "(") {
+		return
+	}
+	end := strings.Index(option.Name, ")")
+	if end < 0 {
+		return
+	}
+	s.addReference(protoFile, option.Position, []string{"("}, nestedNames, option.Name[1:end])
+}
+
+func (s *moveState) addReference(protoFile *moveFile, position scanner.Position, prefixes []string, nestedNames []string, value string) {
+	if value == "" {
+		return
+	}
+	s.references = append(s.references, &moveReference{
+		protoFile:   protoFile,
+		position:    position,
+		prefixes:    prefixes,
+		nestedNames: nestedNames,
+		value:       value,
+	})
+}
+
+// getEdits returns the edits to the package declarations, imports and
+// references of the files.
+func (s *moveState) getEdits(files []*moveFile) (map[*moveFile][]*moveEdit, error) {
+	fileToEdits := make(map[*moveFile][]*moveEdit)
+	importPathToFile := make(map[string]*moveFile, len(files))
+	for _, protoFile := range files {
+		importPathToFile[protoFile.importPath] = protoFile
+	}
+	for _, protoFile := range files {
+		for _, element := range protoFile.descriptor.Elements {
+			switch e := element.(type) {
+			case *proto.Package:
+				if protoFile.newPkg == protoFile.pkg {
+					continue
+				}
+				offset, err := findToken(protoFile.data, e.Position.Offset, []string{"package"}, protoFile.pkg)
+				if err != nil {
+					return nil, fmt.Errorf("%v: %v", e.Position, err)
+				}
+				fileToEdits[protoFile] = append(fileToEdits[protoFile], &moveEdit{
+					offset:  offset,
+					oldText: protoFile.pkg,
+					newText: protoFile.newPkg,
+				})
+			case *proto.Import:
+				importFile, ok := importPathToFile[e.Filename]
+				if !ok || importFile.newImportPath == importFile.importPath {
+					continue
+				}
+				index := strings.Index(protoFile.data[e.Position.Offset:], e.Filename)
+				if index < 0 {
+					return nil, fmt.Errorf("%v: could not find %q", e.Position, e.Filename)
+				}
+				fileToEdits[protoFile] = append(fileToEdits[protoFile], &moveEdit{
+					offset:  e.Position.Offset + index,
+					oldText: e.Filename,
+					newText: importFile.newImportPath,
+				})
+			}
+		}
+		if protoFile.newPkg != protoFile.pkg && getPackage(protoFile.descriptor) == "" {
+			return nil, fmt.Errorf("cannot move %s to package %s as it has no package declaration", protoFile.displayPath, protoFile.newPkg)
+		}
+	}
+	for _, reference := range s.references {
+		fullName, ok := s.symbols.resolve(reference.value, getScope(reference.protoFile.pkg, reference.nestedNames))
+		if !ok {
+			// scalar types, or types outside of the ProtoSet
+			continue
+		}
+		newFullName := s.fullNameToNewFullName[fullName]
+		newScope := getScope(reference.protoFile.newPkg, reference.nestedNames)
+		if resolvedFullName, ok := s.newSymbols.resolve(reference.value, newScope); ok && resolvedFullName == newFullName {
+			continue
+		}
+		offset, err := findToken(reference.protoFile.data, reference.position.Offset, reference.prefixes, reference.value)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", reference.position, err)
+		}
+		fileToEdits[reference.protoFile] = append(fileToEdits[reference.protoFile], &moveEdit{
+			offset:  offset,
+			oldText: reference.value,
+			newText: s.newSymbols.getReference(newFullName, newScope),
+		})
+	}
+	return fileToEdits, nil
+}
+
+// moveSymbols are the names of the types, extensions and packages, which
+// are what the first component of a reference can resolve to.
+type moveSymbols struct {
+	// the full names of the types and extensions
+	fullNames map[string]struct{}
+	names     map[string]struct{}
+}
+
+func newMoveSymbols() *moveSymbols {
+	return &moveSymbols{
+		fullNames: make(map[string]struct{}),
+		names:     make(map[string]struct{}),
+	}
+}
+
+func (s *moveSymbols) addPackage(pkg string) {
+	if pkg == "" {
+		return
+	}
+	// "foo.bar" also declares the package "foo"
+	components := strings.Split(pkg, ".")
+	for i := range components {
+		s.names[strings.Join(components[:i+1], ".")] = struct{}{}
+	}
+}
+
+func (s *moveSymbols) addSymbol(fullName string) {
+	s.fullNames[fullName] = struct{}{}
+	s.names[fullName] = struct{}{}
+}
+
+// resolve resolves the reference to the full name of a type or extension
+// from the given scope, as protoc does. The first component of the
+// reference is searched for from the innermost scope outwards, and the
+// rest of the reference must then be a type or extension.
+func (s *moveSymbols) resolve(value string, scope []string) (string, bool) {
+	if strings.HasPrefix(value, ".") {
+		fullName := strings.TrimPrefix(value, ".")
+		_, ok := s.fullNames[fullName]
+		return fullName, ok
+	}
+	first := value
+	if index := strings.Index(value, "."); index >= 0 {
+		first = value[:index]
+	}
+	for i := len(scope); i >= 0; i-- {
+		scopeName := strings.Join(scope[:i], ".")
+		if _, ok := s.names[joinFullName(scopeName, first)]; !ok {
+			continue
+		}
+		fullName := joinFullName(scopeName, value)
+		_, ok := s.fullNames[fullName]
+		return fullName, ok
+	}
+	return "", false
+}
+
+// getReference returns the shortest reference that resolves to the type or
+// extension from the given scope.
+func (s *moveSymbols) getReference(fullName string, scope []string) string {
+	components := strings.Split(fullName, ".")
+	for i := len(components) - 1; i >= 0; i-- {
+		value := strings.Join(components[i:], ".")
+		if resolvedFullName, ok := s.resolve(value, scope); ok && resolvedFullName == fullName {
+			return value
+		}
+	}
+	return "." + fullName
+}
+
+func getScope(pkg string, nestedNames []string) []string {
+	var scope []string
+	if pkg != "" {
+		scope = strings.Split(pkg, ".")
+	}
+	return append(scope, nestedNames...)
+}
+
+func joinFullName(scopeName string, names ...string) string {
+	if scopeName == "" {
+		return strings.Join(names, ".")
+	}
+	return strings.Join(append([]string{scopeName}, names...), ".")
+}
+
+func appendName(names []string, name string) []string {
+	// copy so that slices of sibling elements do not share arrays
+	result := make([]string, len(names), len(names)+1)
+	copy(result, names)
+	return append(result, name)
+}
+
+// moveEdit is the replacement of text at an offset.
+type moveEdit struct {
+	offset  int
+	oldText string
+	newText string
+}
+
+func applyEdits(data string, edits []*moveEdit) (string, error) {
+	sort.Slice(edits, func(i int, j int) bool { return edits[i].offset > edits[j].offset })
+	previousOffset := -1
+	for _, edit := range edits {
+		if edit.offset == previousOffset {
+			continue
+		}
+		if !strings.HasPrefix(data[edit.offset:], edit.oldText) {
+			return "", fmt.Errorf("expected %q at offset %d", edit.oldText, edit.offset)
+		}
+		data = data[:edit.offset] + edit.newText + data[edit.offset+len(edit.oldText):]
+		previousOffset = edit.offset
+	}
+	return data, nil
+}
+
+// findToken returns the offset of the token in the data, starting at
+// offset and after each of the given prefixes in order.
+//
+// Prefixes that are identifiers must match whole tokens, other prefixes
+// such as "(" match anywhere.
+func findToken(data string, offset int, prefixes []string, token string) (int, error) {
+	for _, prefix := range prefixes {
+		var prefixOffset int
+		var err error
+		if isToken(prefix) {
+			prefixOffset, err = findToken(data, offset, nil, prefix)
+		} else if index := strings.Index(data[offset:], prefix); index >= 0 {
+			prefixOffset = offset + index
+		} else {
+			err = fmt.Errorf("could not find %q", prefix)
+		}
+		if err != nil {
+			return 0, err
+		}
+		offset = prefixOffset + len(prefix)
+	}
+	for {
+		index := strings.Index(data[offset:], token)
+		if index < 0 {
+			return 0, fmt.Errorf("could not find %q", token)
+		}
+		start := offset + index
+		end := start + len(token)
+		if (start == 0 || !isTokenChar(data[start-1])) && (end == len(data) || !isTokenChar(data[end])) {
+			return start, nil
+		}
+		offset = end
+	}
+}
+
+func isToken(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isTokenChar(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isTokenChar(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
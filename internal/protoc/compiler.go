@@ -76,6 +76,7 @@ type compiler struct {
 	protocURL           string
 	doGen               bool
 	doFileDescriptorSet bool
+	includeSourceInfo   bool
 }
 
 func newCompiler(options ...CompilerOption) *compiler {
@@ -350,9 +351,11 @@ func (c *compiler) getCmdMetas(protoSet *file.ProtoSet) (cmdMetas []*cmdMeta, re
 			// if its a temporary file, that means we actually care about the output
 			// so we do --include_imports to get all necessary info in the output file descriptor set
 			if descriptorSetTempFilePath != "" {
-				// TODO(pedge): we will need source info if we switch out emicklei/proto
-				//iArgs = append(iArgs, "--include_source_info")
 				iArgs = append(iArgs, "--include_imports")
+				// source info is needed to report positions from the file descriptors
+				if c.includeSourceInfo {
+					iArgs = append(iArgs, "--include_source_info")
+				}
 			}
 			for _, protoFile := range protoFiles {
 				iArgs = append(iArgs, protoFile.Path)
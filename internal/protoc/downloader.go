@@ -328,7 +328,11 @@ func (d *downloader) getBasePath() (string, error) {
 }
 
 func (d *downloader) getBasePathNoVersionOSARCH() (string, error) {
-	basePath := d.cachePath
+	return getCachePath(d.cachePath)
+}
+
+func getCachePath(cachePath string) (string, error) {
+	basePath := cachePath
 	var err error
 	if basePath == "" {
 		basePath, err = getDefaultBasePathNoOSARCH()
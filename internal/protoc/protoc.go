@@ -63,6 +63,16 @@ type Downloader interface {
 	Delete() error
 }
 
+// GetCachePath returns the absolute path to the cache, which is the given
+// cache path if it is not empty, and ${XDG_CACHE_HOME}/prototool otherwise,
+// with the same defaults for ${XDG_CACHE_HOME} as Downloader.Download.
+//
+// Downloader.Delete deletes this directory, including everything that
+// other packages cache in it.
+func GetCachePath(cachePath string) (string, error) {
+	return getCachePath(cachePath)
+}
+
 // DownloaderOption is an option for a new Downloader.
 type DownloaderOption func(*downloader)
 
@@ -200,6 +210,16 @@ func CompilerWithFileDescriptorSet() CompilerOption {
 	}
 }
 
+// CompilerWithSourceInfo says to include source code info in the
+// returned FileDescriptorSets.
+//
+// This only has an effect with CompilerWithFileDescriptorSet.
+func CompilerWithSourceInfo() CompilerOption {
+	return func(compiler *compiler) {
+		compiler.includeSourceInfo = true
+	}
+}
+
 // NewCompiler returns a new Compiler.
 func NewCompiler(options ...CompilerOption) Compiler {
 	return newCompiler(options...)
@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package report
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/uber/prototool/internal/text"
+)
+
+// the Checkstyle version that the output is compatible with
+const checkstyleVersion = "4.3"
+
+type checkstyleResult struct {
+	XMLName xml.Name          `xml:"checkstyle"`
+	Version string            `xml:"version,attr"`
+	Files   []*checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string             `xml:"name,attr"`
+	Errors []*checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr,omitempty"`
+}
+
+func writeCheckstyle(writer io.Writer, failures []*text.Failure) error {
+	result := &checkstyleResult{
+		Version: checkstyleVersion,
+	}
+	var file *checkstyleFile
+	// failures are sorted by filename, so we group consecutive failures
+	for _, failure := range failures {
+		filename := getFilename(failure)
+		if file == nil || file.Name != filename {
+			file = &checkstyleFile{
+				Name: filename,
+			}
+			result.Files = append(result.Files, file)
+		}
+		checkstyleError := &checkstyleError{
+			Line:     failure.Line,
+			Column:   failure.Column,
+			Severity: getSeverity(failure),
+			Message:  failure.Message,
+		}
+		if failure.LintID != "" {
+			checkstyleError.Source = toolName + "." + failure.LintID
+		}
+		file.Errors = append(file.Errors, checkstyleError)
+	}
+	return writeXML(writer, result)
+}
+
+func writeXML(writer io.Writer, v interface{}) error {
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
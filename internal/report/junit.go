@@ -0,0 +1,114 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package report
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+
+	"github.com/uber/prototool/internal/text"
+)
+
+type junitTestSuites struct {
+	XMLName    xml.Name          `xml:"testsuites"`
+	Name       string            `xml:"name,attr"`
+	Tests      int               `xml:"tests,attr"`
+	Failures   int               `xml:"failures,attr"`
+	TestSuites []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func writeJUnit(writer io.Writer, rules []*Rule, failures []*text.Failure) error {
+	idToRule := make(map[string]*Rule, len(rules))
+	for _, rule := range rules {
+		idToRule[rule.ID] = rule
+	}
+	testSuites := &junitTestSuites{
+		Name:     toolName,
+		Tests:    len(failures),
+		Failures: len(failures),
+	}
+	var testSuite *junitTestSuite
+	// failures are sorted by filename, so we group consecutive failures
+	for _, failure := range failures {
+		filename := getFilename(failure)
+		if testSuite == nil || testSuite.Name != filename {
+			testSuite = &junitTestSuite{
+				Name: filename,
+			}
+			testSuites.TestSuites = append(testSuites.TestSuites, testSuite)
+		}
+		name := failure.LintID
+		if name == "" {
+			name = failure.Message
+		}
+		buffer := bytes.NewBuffer(nil)
+		if err := failure.Fprintln(
+			buffer,
+			text.FailureFieldFilename,
+			text.FailureFieldLine,
+			text.FailureFieldColumn,
+			text.FailureFieldSeverity,
+			text.FailureFieldMessage,
+		); err != nil {
+			return err
+		}
+		if rule, ok := idToRule[failure.LintID]; ok && rule.Description != "" {
+			if _, err := buffer.WriteString(rule.Description); err != nil {
+				return err
+			}
+		}
+		testSuite.Tests++
+		testSuite.Failures++
+		testSuite.TestCases = append(
+			testSuite.TestCases,
+			&junitTestCase{
+				Name:      name,
+				ClassName: filename,
+				Failure: &junitFailure{
+					Message: failure.Message,
+					Type:    failure.LintID,
+					Text:    buffer.String(),
+				},
+			},
+		)
+	}
+	return writeXML(writer, testSuites)
+}
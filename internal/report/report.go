@@ -0,0 +1,152 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package report writes complete lists of Failures in formats consumable
+// by CI systems, such as SARIF, Checkstyle XML and JUnit XML.
+package report
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uber/prototool/internal/text"
+)
+
+const (
+	// FormatSARIF is the SARIF 2.1.0 format, as consumed by GitHub code scanning.
+	FormatSARIF Format = iota + 1
+	// FormatCheckstyle is the Checkstyle XML format.
+	FormatCheckstyle
+	// FormatJUnit is the JUnit XML format.
+	FormatJUnit
+
+	toolName           = "prototool"
+	toolInformationURI = "https://github.com/uber/prototool"
+)
+
+var (
+	_formatToString = map[Format]string{
+		FormatSARIF:      "sarif",
+		FormatCheckstyle: "checkstyle",
+		FormatJUnit:      "junit",
+	}
+	_stringToFormat = map[string]Format{
+		"sarif":      FormatSARIF,
+		"checkstyle": FormatCheckstyle,
+		"junit":      FormatJUnit,
+	}
+)
+
+// Format is an output format for a list of Failures.
+type Format int
+
+// String implements fmt.Stringer.
+func (f Format) String() string {
+	if s, ok := _formatToString[f]; ok {
+		return s
+	}
+	return strconv.Itoa(int(f))
+}
+
+// ParseFormat parses the Format from the given string.
+//
+// Input is case-insensitive.
+func ParseFormat(s string) (Format, error) {
+	format, ok := _stringToFormat[strings.ToLower(s)]
+	if !ok {
+		return 0, fmt.Errorf("could not parse %s to an output format, must be one of sarif, checkstyle, junit", s)
+	}
+	return format, nil
+}
+
+// Rule is metadata for the Failures with a given ID.
+type Rule struct {
+	// The ID of the Rule, matching text.Failure.LintID.
+	ID string
+	// The human-readable description of the Rule.
+	Description string
+}
+
+// Write writes the Failures to the writer in the given Format.
+//
+// The Failures will be sorted. Rules are used to add metadata for the IDs
+// of the Failures, and IDs without an associated Rule are still written.
+// The toolVersion is written for formats that support it.
+func Write(writer io.Writer, format Format, toolVersion string, rules []*Rule, failures []*text.Failure) error {
+	text.SortFailures(failures)
+	switch format {
+	case FormatSARIF:
+		return writeSARIF(writer, toolVersion, getUsedRules(rules, failures), failures)
+	case FormatCheckstyle:
+		return writeCheckstyle(writer, failures)
+	case FormatJUnit:
+		return writeJUnit(writer, getUsedRules(rules, failures), failures)
+	default:
+		return fmt.Errorf("unknown output format: %v", format)
+	}
+}
+
+// getUsedRules returns the Rules for the IDs of the given Failures, sorted by ID.
+//
+// If an ID does not have an associated Rule, a Rule with only the ID is returned.
+func getUsedRules(rules []*Rule, failures []*text.Failure) []*Rule {
+	idToRule := make(map[string]*Rule, len(rules))
+	for _, rule := range rules {
+		idToRule[rule.ID] = rule
+	}
+	var usedRules []*Rule
+	seen := make(map[string]struct{})
+	for _, failure := range failures {
+		if failure.LintID == "" {
+			continue
+		}
+		if _, ok := seen[failure.LintID]; ok {
+			continue
+		}
+		seen[failure.LintID] = struct{}{}
+		rule, ok := idToRule[failure.LintID]
+		if !ok {
+			rule = &Rule{ID: failure.LintID}
+		}
+		usedRules = append(usedRules, rule)
+	}
+	sort.Slice(usedRules, func(i int, j int) bool { return usedRules[i].ID < usedRules[j].ID })
+	return usedRules
+}
+
+// getSeverity returns the severity of the Failure, defaulting to text.SeverityError.
+func getSeverity(failure *text.Failure) string {
+	if failure.Severity == "" {
+		return text.SeverityError
+	}
+	return failure.Severity
+}
+
+// getFilename returns the filename of the Failure, defaulting to <input>
+// to match text.Failure.Fprintln.
+func getFilename(failure *text.Failure) string {
+	if failure.Filename == "" {
+		return "<input>"
+	}
+	return failure.Filename
+}
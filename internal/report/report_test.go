@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/prototool/internal/text"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, format := range []Format{FormatSARIF, FormatCheckstyle, FormatJUnit} {
+		parsed, err := ParseFormat(format.String())
+		assert.NoError(t, err)
+		assert.Equal(t, format, parsed)
+	}
+	format, err := ParseFormat("SARIF")
+	assert.NoError(t, err)
+	assert.Equal(t, FormatSARIF, format)
+	_, err = ParseFormat("xml")
+	assert.Error(t, err)
+}
+
+func TestWriteSARIF(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, Write(buffer, FormatSARIF, "1.0.0", newTestRules(), newTestFailures()))
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(buffer.Bytes(), &log))
+	assert.Equal(t, sarifVersion, log.Version)
+	require.Len(t, log.Runs, 1)
+	driver := log.Runs[0].Tool.Driver
+	assert.Equal(t, "prototool", driver.Name)
+	assert.Equal(t, "1.0.0", driver.Version)
+	require.Len(t, driver.Rules, 2)
+	assert.Equal(t, "BAR", driver.Rules[0].ID)
+	assert.Nil(t, driver.Rules[0].ShortDescription)
+	assert.Equal(t, "FOO", driver.Rules[1].ID)
+	assert.Equal(t, "Checks foo.", driver.Rules[1].ShortDescription.Text)
+	results := log.Runs[0].Results
+	require.Len(t, results, 3)
+	assert.Equal(t, "", results[0].RuleID)
+	assert.Nil(t, results[0].RuleIndex)
+	assert.Equal(t, "error", results[0].Level)
+	assert.Empty(t, results[0].Locations)
+	assert.Equal(t, "FOO", results[1].RuleID)
+	assert.Equal(t, 1, *results[1].RuleIndex)
+	assert.Equal(t, "warning", results[1].Level)
+	assert.Equal(t, "a/a.proto", results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, &sarifRegion{StartLine: 2, StartColumn: 3}, results[1].Locations[0].PhysicalLocation.Region)
+	assert.Equal(t, "BAR", results[2].RuleID)
+	assert.Equal(t, 0, *results[2].RuleIndex)
+	assert.Equal(t, "note", results[2].Level)
+	assert.Nil(t, results[2].Locations[0].PhysicalLocation.Region)
+}
+
+func TestWriteSARIFEmpty(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, Write(buffer, FormatSARIF, "1.0.0", newTestRules(), nil))
+	assert.Contains(t, buffer.String(), `"results": []`)
+	assert.NotContains(t, buffer.String(), `"rules"`)
+}
+
+func TestWriteCheckstyle(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, Write(buffer, FormatCheckstyle, "1.0.0", newTestRules(), newTestFailures()))
+	assert.Equal(t,
+		`<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="&lt;input&gt;">
+    <error severity="error" message="compile failed"></error>
+  </file>
+  <file name="a/a.proto">
+    <error line="2" column="3" severity="warning" message="foo &amp; bar" source="prototool.FOO"></error>
+  </file>
+  <file name="b.proto">
+    <error severity="info" message="bar" source="prototool.BAR"></error>
+  </file>
+</checkstyle>
+`,
+		buffer.String(),
+	)
+}
+
+func TestWriteJUnit(t *testing.T) {
+	buffer := bytes.NewBuffer(nil)
+	require.NoError(t, Write(buffer, FormatJUnit, "1.0.0", newTestRules(), newTestFailures()))
+	assert.Equal(t,
+		`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="prototool" tests="3" failures="3">
+  <testsuite name="&lt;input&gt;" tests="1" failures="1">
+    <testcase name="compile failed" classname="&lt;input&gt;">
+      <failure message="compile failed">&lt;input&gt;:1:1:error:compile failed&#xA;</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="a/a.proto" tests="1" failures="1">
+    <testcase name="FOO" classname="a/a.proto">
+      <failure message="foo &amp; bar" type="FOO">a/a.proto:2:3:warning:foo &amp; bar&#xA;Checks foo.</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="b.proto" tests="1" failures="1">
+    <testcase name="BAR" classname="b.proto">
+      <failure message="bar" type="BAR">b.proto:1:1:info:bar&#xA;</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`,
+		buffer.String(),
+	)
+}
+
+func newTestRules() []*Rule {
+	return []*Rule{
+		{
+			ID:          "FOO",
+			Description: "Checks foo.",
+		},
+		{
+			ID:          "BAZ",
+			Description: "Checks baz.",
+		},
+	}
+}
+
+func newTestFailures() []*text.Failure {
+	return []*text.Failure{
+		{
+			Filename: "b.proto",
+			LintID:   "BAR",
+			Message:  "bar",
+			Severity: text.SeverityInfo,
+		},
+		{
+			Filename: "a/a.proto",
+			Line:     2,
+			Column:   3,
+			LintID:   "FOO",
+			Message:  "foo & bar",
+			Severity: text.SeverityWarning,
+		},
+		{
+			Message: "compile failed",
+		},
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+
+	"github.com/uber/prototool/internal/text"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+var _severityToSARIFLevel = map[string]string{
+	text.SeverityError:   "error",
+	text.SeverityWarning: "warning",
+	text.SeverityInfo:    "note",
+}
+
+type sarifLog struct {
+	Schema  string      `json:"$schema"`
+	Version string      `json:"version"`
+	Runs    []*sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    *sarifTool     `json:"tool"`
+	Results []*sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver *sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string       `json:"name"`
+	Version        string       `json:"version,omitempty"`
+	InformationURI string       `json:"informationUri"`
+	Rules          []*sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+}
+
+type sarifResult struct {
+	RuleID    string           `json:"ruleId,omitempty"`
+	RuleIndex *int             `json:"ruleIndex,omitempty"`
+	Level     string           `json:"level"`
+	Message   *sarifMessage    `json:"message"`
+	Locations []*sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation *sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion           `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func writeSARIF(writer io.Writer, toolVersion string, rules []*Rule, failures []*text.Failure) error {
+	driver := &sarifDriver{
+		Name:           toolName,
+		Version:        toolVersion,
+		InformationURI: toolInformationURI,
+	}
+	idToRuleIndex := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		sarifRule := &sarifRule{
+			ID: rule.ID,
+		}
+		if rule.Description != "" {
+			sarifRule.ShortDescription = &sarifMessage{
+				Text: rule.Description,
+			}
+		}
+		driver.Rules = append(driver.Rules, sarifRule)
+		idToRuleIndex[rule.ID] = i
+	}
+	// results must be an empty array and not null if there are no failures
+	results := make([]*sarifResult, 0, len(failures))
+	for _, failure := range failures {
+		result := &sarifResult{
+			RuleID: failure.LintID,
+			Level:  _severityToSARIFLevel[getSeverity(failure)],
+			Message: &sarifMessage{
+				Text: failure.Message,
+			},
+		}
+		if ruleIndex, ok := idToRuleIndex[failure.LintID]; ok {
+			result.RuleIndex = &ruleIndex
+		}
+		if failure.Filename != "" {
+			location := &sarifLocation{
+				PhysicalLocation: &sarifPhysicalLocation{
+					ArtifactLocation: &sarifArtifactLocation{
+						URI: filepath.ToSlash(failure.Filename),
+					},
+				},
+			}
+			// SARIF requires regions to start at line 1
+			if failure.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartLine:   failure.Line,
+					StartColumn: failure.Column,
+				}
+			}
+			result.Locations = []*sarifLocation{location}
+		}
+		results = append(results, result)
+	}
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(
+		&sarifLog{
+			Schema:  sarifSchema,
+			Version: sarifVersion,
+			Runs: []*sarifRun{
+				{
+					Tool: &sarifTool{
+						Driver: driver,
+					},
+					Results: results,
+				},
+			},
+		},
+	)
+}
@@ -29,9 +29,13 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/uber/prototool/internal/spell"
 	"github.com/uber/prototool/internal/strs"
+	"github.com/uber/prototool/internal/text"
 	"go.uber.org/zap"
 	yaml "gopkg.in/yaml.v2"
 )
@@ -224,6 +228,75 @@ func externalConfigToConfig(develMode bool, e ExternalConfig, dirPath string) (C
 			ignoreIDToFilePaths[id] = append(ignoreIDToFilePaths[id], protoFilePath)
 		}
 	}
+	var idToSeverity map[string]string
+	for id, severity := range e.Lint.Severities {
+		parsedSeverity, err := text.ParseSeverity(severity)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid severity for lint id %s: %v", id, err)
+		}
+		if idToSeverity == nil {
+			idToSeverity = make(map[string]string)
+		}
+		idToSeverity[strings.ToUpper(id)] = parsedSeverity
+	}
+	lintLimits := LintLimits{
+		MaxMessageFields: e.Lint.Limits.MaxMessageFields,
+		MaxMessageDepth:  e.Lint.Limits.MaxMessageDepth,
+		MaxServiceRPCs:   e.Lint.Limits.MaxServiceRPCs,
+		MaxFileLines:     e.Lint.Limits.MaxFileLines,
+		MaxFileImports:   e.Lint.Limits.MaxFileImports,
+	}
+	if err := checkLintLimits(lintLimits); err != nil {
+		return Config{}, err
+	}
+	lintImportRules := make([]LintImportRule, len(e.Lint.Imports))
+	for i, externalImportRule := range e.Lint.Imports {
+		lintImportRules[i] = LintImportRule{
+			DirPattern:    externalImportRule.Dir,
+			AllowPatterns: externalImportRule.Allow,
+			DenyPatterns:  externalImportRule.Deny,
+		}
+		if err := checkLintImportRule(lintImportRules[i]); err != nil {
+			return Config{}, err
+		}
+	}
+	// to make testing easier
+	if len(lintImportRules) == 0 {
+		lintImportRules = nil
+	}
+	deprecationMaxAge, err := parseDeprecationMaxAge(e.Lint.Deprecations.MaxAge)
+	if err != nil {
+		return Config{}, err
+	}
+	var canonicalFieldNameToType map[string]string
+	for _, canonicalField := range e.Lint.CanonicalFields {
+		if canonicalField.Name == "" {
+			return Config{}, fmt.Errorf("name for canonical field is empty")
+		}
+		fieldType := strings.Join(strings.Fields(canonicalField.Type), " ")
+		if fieldType == "" {
+			return Config{}, fmt.Errorf("type for canonical field %s is empty", canonicalField.Name)
+		}
+		if canonicalFieldNameToType == nil {
+			canonicalFieldNameToType = make(map[string]string)
+		}
+		if _, ok := canonicalFieldNameToType[canonicalField.Name]; ok {
+			return Config{}, fmt.Errorf("duplicate canonical field %s", canonicalField.Name)
+		}
+		canonicalFieldNameToType[canonicalField.Name] = fieldType
+	}
+	extensionsAllowedMessages, err := getLintAllowedMessages("extensions", e.Lint.Extensions.Allow)
+	if err != nil {
+		return Config{}, err
+	}
+	proto3OptionalAllowedMessages, err := getLintAllowedMessages("proto3_optional", e.Lint.Proto3Optional.Allow)
+	if err != nil {
+		return Config{}, err
+	}
+	lintGroups, err := getLintGroups(e.Lint.Groups, e.Lint.GroupFiles, dirPath)
+	if err != nil {
+		return Config{}, err
+	}
 
 	genPlugins := make([]GenPlugin, len(e.Gen.Plugins))
 	for i, plugin := range e.Gen.Plugins {
@@ -314,6 +387,31 @@ func externalConfigToConfig(develMode bool, e ExternalConfig, dirPath string) (C
 		fileHeader = strings.Join(fileHeaderLines, "\n")
 	}
 
+	var dictionaryWords []string
+	if e.Lint.Dictionary.Path != "" {
+		if filepath.IsAbs(e.Lint.Dictionary.Path) {
+			return Config{}, fmt.Errorf("path for dictionary must be relative: %s", e.Lint.Dictionary.Path)
+		}
+		dictionaryData, err := ioutil.ReadFile(filepath.Join(dirPath, e.Lint.Dictionary.Path))
+		if err != nil {
+			return Config{}, err
+		}
+		dictionaryWords = spell.ParseWords(dictionaryData)
+	}
+
+	if e.Format.MaxLineLength < 0 {
+		return Config{}, fmt.Errorf("max_line_length for format must be non-negative: %d", e.Format.MaxLineLength)
+	}
+	if e.Format.IndentWidth < 0 {
+		return Config{}, fmt.Errorf("indent_width for format must be non-negative: %d", e.Format.IndentWidth)
+	}
+	if e.Format.CommentWidth < 0 {
+		return Config{}, fmt.Errorf("comment_width for format must be non-negative: %d", e.Format.CommentWidth)
+	}
+	if e.Format.TopLevelBlankLines < 0 {
+		return Config{}, fmt.Errorf("top_level_blank_lines for format must be non-negative: %d", e.Format.TopLevelBlankLines)
+	}
+
 	if !develMode {
 		if e.Lint.AllowSuppression {
 			return Config{}, fmt.Errorf("allow_suppression is not allowed outside of internal prototool tests")
@@ -333,13 +431,22 @@ func externalConfigToConfig(develMode bool, e ExternalConfig, dirPath string) (C
 			DirPathToBasePackage: createDirPathToBasePackage,
 		},
 		Lint: LintConfig{
-			IncludeIDs:          strs.DedupeSort(e.Lint.Rules.Add, strings.ToUpper),
-			ExcludeIDs:          strs.DedupeSort(e.Lint.Rules.Remove, strings.ToUpper),
-			Group:               strings.ToLower(e.Lint.Group),
-			NoDefault:           e.Lint.Rules.NoDefault,
-			IgnoreIDToFilePaths: ignoreIDToFilePaths,
-			FileHeader:          fileHeader,
-			AllowSuppression:    e.Lint.AllowSuppression,
+			IncludeIDs:                    strs.DedupeSort(e.Lint.Rules.Add, strings.ToUpper),
+			ExcludeIDs:                    strs.DedupeSort(e.Lint.Rules.Remove, strings.ToUpper),
+			Group:                         strings.ToLower(e.Lint.Group),
+			Groups:                        lintGroups,
+			NoDefault:                     e.Lint.Rules.NoDefault,
+			IgnoreIDToFilePaths:           ignoreIDToFilePaths,
+			FileHeader:                    fileHeader,
+			AllowSuppression:              e.Lint.AllowSuppression,
+			IDToSeverity:                  idToSeverity,
+			Limits:                        lintLimits,
+			ImportRules:                   lintImportRules,
+			DeprecationMaxAge:             deprecationMaxAge,
+			CanonicalFieldNameToType:      canonicalFieldNameToType,
+			DictionaryWords:               dictionaryWords,
+			ExtensionsAllowedMessages:     extensionsAllowedMessages,
+			Proto3OptionalAllowedMessages: proto3OptionalAllowedMessages,
 		},
 		Gen: GenConfig{
 			GoPluginOptions: GenGoPluginOptions{
@@ -348,6 +455,16 @@ func externalConfigToConfig(develMode bool, e ExternalConfig, dirPath string) (C
 			},
 			Plugins: genPlugins,
 		},
+		Format: FormatConfig{
+			MaxLineLength:      e.Format.MaxLineLength,
+			IndentWidth:        e.Format.IndentWidth,
+			UseTabs:            e.Format.UseTabs,
+			AlignFields:        e.Format.AlignFields,
+			AlignEnumValues:    e.Format.AlignEnumValues,
+			TopLevelBlankLines: e.Format.TopLevelBlankLines,
+			CanonicalOrder:     e.Format.CanonicalOrder,
+			CommentWidth:       e.Format.CommentWidth,
+		},
 	}
 
 	for _, genPlugin := range config.Gen.Plugins {
@@ -374,6 +491,154 @@ func externalConfigToConfig(develMode bool, e ExternalConfig, dirPath string) (C
 	return config, nil
 }
 
+// checkLintLimits returns an error if any of the lint limits is negative.
+func checkLintLimits(lintLimits LintLimits) error {
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{
+		{"max_message_fields", lintLimits.MaxMessageFields},
+		{"max_message_depth", lintLimits.MaxMessageDepth},
+		{"max_service_rpcs", lintLimits.MaxServiceRPCs},
+		{"max_file_lines", lintLimits.MaxFileLines},
+		{"max_file_imports", lintLimits.MaxFileImports},
+	} {
+		if limit.value < 0 {
+			return fmt.Errorf("lint limit %s must not be negative: %d", limit.name, limit.value)
+		}
+	}
+	return nil
+}
+
+// checkLintImportRule returns an error if the lint import rule does not
+// have a valid directory pattern and at least one valid import pattern.
+func checkLintImportRule(lintImportRule LintImportRule) error {
+	if lintImportRule.DirPattern == "" {
+		return fmt.Errorf("dir for lint import rule is empty")
+	}
+	if strings.HasPrefix(lintImportRule.DirPattern, "/") || filepath.IsAbs(lintImportRule.DirPattern) {
+		return fmt.Errorf("dir for lint import rule must be relative: %s", lintImportRule.DirPattern)
+	}
+	if len(lintImportRule.AllowPatterns) == 0 && len(lintImportRule.DenyPatterns) == 0 {
+		return fmt.Errorf("lint import rule for dir %s must have allow or deny set", lintImportRule.DirPattern)
+	}
+	for _, pattern := range append(append([]string{lintImportRule.DirPattern}, lintImportRule.AllowPatterns...), lintImportRule.DenyPatterns...) {
+		if _, err := strs.MatchSlashGlob(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q for lint import rule for dir %s: %v", pattern, lintImportRule.DirPattern, err)
+		}
+	}
+	return nil
+}
+
+// parseDeprecationMaxAge parses the maximum age of deprecations, which is
+// either a number of days such as "90d", or a duration such as "720h".
+func parseDeprecationMaxAge(maxAge string) (time.Duration, error) {
+	if maxAge == "" {
+		return 0, nil
+	}
+	var duration time.Duration
+	if days := strings.TrimSuffix(maxAge, "d"); days != maxAge {
+		numDays, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse deprecation max_age %q: %v", maxAge, err)
+		}
+		duration = time.Duration(numDays) * 24 * time.Hour
+	} else {
+		parsedDuration, err := time.ParseDuration(maxAge)
+		if err != nil {
+			return 0, fmt.Errorf("could not parse deprecation max_age %q: %v", maxAge, err)
+		}
+		duration = parsedDuration
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("deprecation max_age must be positive: %s", maxAge)
+	}
+	return duration, nil
+}
+
+// getLintAllowedMessages returns the fully-qualified message names of the
+// allow list for the given lint configuration key, without leading periods.
+func getLintAllowedMessages(key string, names []string) ([]string, error) {
+	var allowedMessages []string
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = strings.TrimPrefix(strings.TrimSpace(name), ".")
+		if name == "" {
+			return nil, fmt.Errorf("message name for lint.%s.allow is empty", key)
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate message name for lint.%s.allow: %s", key, name)
+		}
+		seen[name] = struct{}{}
+		allowedMessages = append(allowedMessages, name)
+	}
+	return allowedMessages, nil
+}
+
+// getLintGroups gets the user-defined lint groups from the config and from
+// the lint group files.
+//
+// Lint group file paths are relative to dirPath unless they are absolute.
+func getLintGroups(externalLintGroups []ExternalLintGroup, groupFiles []string, dirPath string) (map[string]LintGroup, error) {
+	allExternalLintGroups := make([]ExternalLintGroup, 0, len(externalLintGroups))
+	allExternalLintGroups = append(allExternalLintGroups, externalLintGroups...)
+	for _, groupFile := range strs.DedupeSort(groupFiles, nil) {
+		if !filepath.IsAbs(groupFile) {
+			groupFile = filepath.Join(dirPath, groupFile)
+		}
+		externalLintGroupFile, err := getExternalLintGroupFile(filepath.Clean(groupFile))
+		if err != nil {
+			return nil, err
+		}
+		allExternalLintGroups = append(allExternalLintGroups, externalLintGroupFile.Groups...)
+	}
+	var lintGroups map[string]LintGroup
+	for _, externalLintGroup := range allExternalLintGroups {
+		name := strings.ToLower(externalLintGroup.Name)
+		if name == "" {
+			return nil, fmt.Errorf("name for lint group is empty")
+		}
+		if _, ok := lintGroups[name]; ok {
+			return nil, fmt.Errorf("duplicate lint group: %s", name)
+		}
+		lintGroup := LintGroup{
+			Extends:    strings.ToLower(externalLintGroup.Extends),
+			IncludeIDs: strs.DedupeSort(externalLintGroup.Add, strings.ToUpper),
+			ExcludeIDs: strs.DedupeSort(externalLintGroup.Remove, strings.ToUpper),
+		}
+		if intersection := strs.Intersection(lintGroup.IncludeIDs, lintGroup.ExcludeIDs); len(intersection) > 0 {
+			return nil, fmt.Errorf("lint group %s had intersection of %v between add and remove", name, intersection)
+		}
+		if lintGroups == nil {
+			lintGroups = make(map[string]LintGroup)
+		}
+		lintGroups[name] = lintGroup
+	}
+	return lintGroups, nil
+}
+
+func getExternalLintGroupFile(filePath string) (ExternalLintGroupFile, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return ExternalLintGroupFile{}, err
+	}
+	externalLintGroupFile := ExternalLintGroupFile{}
+	switch filepath.Ext(filePath) {
+	case ".json":
+		if err := jsonUnmarshalStrict(data, &externalLintGroupFile); err != nil {
+			return ExternalLintGroupFile{}, err
+		}
+		return externalLintGroupFile, nil
+	case ".yaml":
+		if err := yaml.UnmarshalStrict(data, &externalLintGroupFile); err != nil {
+			return ExternalLintGroupFile{}, err
+		}
+		return externalLintGroupFile, nil
+	default:
+		return ExternalLintGroupFile{}, fmt.Errorf("unknown lint group file extension, must be .json or .yaml: %s", filePath)
+	}
+}
+
 func getExcludePrefixesForDir(dirPath string) ([]string, error) {
 	filePath, err := getSingleFilePathForDir(dirPath)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 )
@@ -142,6 +143,8 @@ type Config struct {
 	Lint LintConfig
 	// The gen config.
 	Gen GenConfig
+	// The format config.
+	Format FormatConfig
 }
 
 // CompileConfig is the compile config.
@@ -160,6 +163,35 @@ type CompileConfig struct {
 	AllowUnusedImports bool
 }
 
+// FormatConfig is the format config.
+type FormatConfig struct {
+	// MaxLineLength is the maximum length of a line, past which compact
+	// field options and RPC signatures are broken across lines.
+	// A value of 0 means there is no maximum.
+	MaxLineLength int
+	// IndentWidth is the number of spaces per indent level, or the width
+	// of a tab when counting line lengths if UseTabs is set.
+	// A value of 0 means the default of 2.
+	IndentWidth int
+	// UseTabs says to indent with tabs instead of spaces.
+	UseTabs bool
+	// AlignFields says to align the names and numbers of consecutive
+	// message fields in columns.
+	AlignFields bool
+	// AlignEnumValues says to align the numbers of consecutive enum
+	// values in a column.
+	AlignEnumValues bool
+	// TopLevelBlankLines is the number of blank lines between top-level
+	// elements. A value of 0 means the default of 1.
+	TopLevelBlankLines int
+	// CanonicalOrder says to order top-level elements and sort options
+	// in message, enum and service bodies when formatting with fix.
+	CanonicalOrder bool
+	// CommentWidth is the maximum line length that comments are
+	// reflowed to. A value of 0 means comments are not reflowed.
+	CommentWidth int
+}
+
 // CreateConfig is the create config.
 type CreateConfig struct {
 	// The map from directory to the package to use as the base.
@@ -172,8 +204,12 @@ type LintConfig struct {
 	// Group is the specific group of linters to use.
 	// The default group is the "default" lint group, which is equal
 	// to the "uber1" lint group.
+	// This can be a built-in group or a group defined in Groups.
 	// Setting this value will result in NoDefault being ignored.
 	Group string
+	// Groups is the map from name to user-defined lint group.
+	// Names expected to be all lower-case.
+	Groups map[string]LintGroup
 	// NoDefault is set to exclude the default set of linters.
 	// This value is ignored if Group is set.
 	NoDefault bool
@@ -200,6 +236,94 @@ type LintConfig struct {
 	FileHeader string
 	// AllowSuppression says to honor @suppresswarnings annotations.
 	AllowSuppression bool
+	// IDToSeverity is the map of ID to severity that overrides the
+	// default severity of the linter.
+	// IDs expected to be all upper-case.
+	// Severities expected to be one of error, warning, info.
+	IDToSeverity map[string]string
+	// Limits are the structural limits checked by the limit linters.
+	Limits LintLimits
+	// ImportRules are the rules for the imports of the files in
+	// specific directories, checked by the IMPORTS_ALLOWED and
+	// IMPORTS_NOT_DENIED linters.
+	ImportRules []LintImportRule
+	// DeprecationMaxAge is the maximum time that an element can be
+	// deprecated for before it should be deleted, checked by the
+	// DEPRECATIONS_NOT_EXPIRED linter.
+	// A value of 0 means there is no maximum.
+	DeprecationMaxAge time.Duration
+	// CanonicalFieldNameToType is the map from field name to the type that
+	// all fields with the name must have, checked by the
+	// MESSAGE_FIELDS_CONSISTENT linter.
+	// Types are prefixed with "repeated " for repeated fields.
+	CanonicalFieldNameToType map[string]string
+	// DictionaryWords are the words of the project dictionary, which are
+	// accepted in addition to the bundled English wordlist by the
+	// COMMENTS_SPELLED_CORRECTLY and NAMES_SPELLED_CORRECTLY linters.
+	DictionaryWords []string
+	// ExtensionsAllowedMessages are the fully-qualified names of the
+	// messages that can declare extension ranges or be extended, checked
+	// by the EXTENSIONS_ALLOWED linter.
+	// Names expected to have no leading period.
+	ExtensionsAllowedMessages []string
+	// Proto3OptionalAllowedMessages are the fully-qualified names of the
+	// messages whose fields can be optional in proto3 files, checked by
+	// the FIELDS_NOT_PROTO3_OPTIONAL linter.
+	// Names expected to have no leading period.
+	Proto3OptionalAllowedMessages []string
+}
+
+// LintImportRule is a rule for the imports of the files in the
+// directories that match DirPattern.
+//
+// Patterns are slash-separated globs where "**" matches any number of
+// directories, as matched by strs.MatchSlashGlob.
+type LintImportRule struct {
+	// DirPattern is the pattern for the directories of the files this
+	// rule applies to, relative to the directory of the configuration file.
+	DirPattern string
+	// AllowPatterns are the patterns for the import paths that are allowed.
+	// If empty, all imports that do not match DenyPatterns are allowed.
+	AllowPatterns []string
+	// DenyPatterns are the patterns for the import paths that are not allowed.
+	DenyPatterns []string
+}
+
+// LintLimits are the structural limits checked by the limit linters.
+//
+// A value of 0 means there is no limit.
+type LintLimits struct {
+	// MaxMessageFields is the maximum number of fields in a message,
+	// including fields in oneofs.
+	MaxMessageFields int
+	// MaxMessageDepth is the maximum depth of nested messages, where
+	// top-level messages have a depth of 1.
+	MaxMessageDepth int
+	// MaxServiceRPCs is the maximum number of RPCs in a service.
+	MaxServiceRPCs int
+	// MaxFileLines is the maximum number of lines in a file.
+	MaxFileLines int
+	// MaxFileImports is the maximum number of imports in a file.
+	MaxFileImports int
+}
+
+// LintGroup is a user-defined lint group.
+type LintGroup struct {
+	// Extends is the lint group that this group starts from, either
+	// a built-in group or another user-defined group.
+	// Expected to be all lower-case.
+	// If empty, the group starts with no linters.
+	Extends string
+	// IncludeIDs are the list of linter IDs to add to the extended group.
+	// Expected to be all uppercase.
+	// Expected to be unique.
+	// Expected to have no overlap with ExcludeIDs.
+	IncludeIDs []string
+	// ExcludeIDs are the list of linter IDs to remove from the extended group.
+	// Expected to be all uppercase.
+	// Expected to be unique.
+	// Expected to have no overlap with IncludeIDs.
+	ExcludeIDs []string
 }
 
 // GenConfig is the gen config.
@@ -297,10 +421,41 @@ type ExternalConfig struct {
 			Add       []string `json:"add" yaml:"add"`
 			Remove    []string `json:"remove" yaml:"remove"`
 		}
+		Severities map[string]string   `json:"severities,omitempty" yaml:"severities,omitempty"`
+		Groups     []ExternalLintGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+		GroupFiles []string            `json:"group_files,omitempty" yaml:"group_files,omitempty"`
 		FileHeader struct {
 			Path        string `json:"path,omitempty" yaml:"path,omitempty"`
 			IsCommented bool   `json:"is_commented,omitempty" yaml:"is_commented,omitempty"`
 		} `json:"file_header,omitempty" yaml:"file_header,omitempty"`
+		Limits struct {
+			MaxMessageFields int `json:"max_message_fields,omitempty" yaml:"max_message_fields,omitempty"`
+			MaxMessageDepth  int `json:"max_message_depth,omitempty" yaml:"max_message_depth,omitempty"`
+			MaxServiceRPCs   int `json:"max_service_rpcs,omitempty" yaml:"max_service_rpcs,omitempty"`
+			MaxFileLines     int `json:"max_file_lines,omitempty" yaml:"max_file_lines,omitempty"`
+			MaxFileImports   int `json:"max_file_imports,omitempty" yaml:"max_file_imports,omitempty"`
+		} `json:"limits,omitempty" yaml:"limits,omitempty"`
+		Imports []struct {
+			Dir   string   `json:"dir,omitempty" yaml:"dir,omitempty"`
+			Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+			Deny  []string `json:"deny,omitempty" yaml:"deny,omitempty"`
+		} `json:"imports,omitempty" yaml:"imports,omitempty"`
+		Deprecations struct {
+			MaxAge string `json:"max_age,omitempty" yaml:"max_age,omitempty"`
+		} `json:"deprecations,omitempty" yaml:"deprecations,omitempty"`
+		CanonicalFields []struct {
+			Name string `json:"name,omitempty" yaml:"name,omitempty"`
+			Type string `json:"type,omitempty" yaml:"type,omitempty"`
+		} `json:"canonical_fields,omitempty" yaml:"canonical_fields,omitempty"`
+		Dictionary struct {
+			Path string `json:"path,omitempty" yaml:"path,omitempty"`
+		} `json:"dictionary,omitempty" yaml:"dictionary,omitempty"`
+		Extensions struct {
+			Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+		} `json:"extensions,omitempty" yaml:"extensions,omitempty"`
+		Proto3Optional struct {
+			Allow []string `json:"allow,omitempty" yaml:"allow,omitempty"`
+		} `json:"proto3_optional,omitempty" yaml:"proto3_optional,omitempty"`
 		// devel-mode only
 		AllowSuppression bool `json:"allow_suppression,omitempty" yaml:"allow_suppression,omitempty"`
 	} `json:"lint,omitempty" yaml:"lint,omitempty"`
@@ -320,6 +475,16 @@ type ExternalConfig struct {
 			IncludeSourceInfo bool   `json:"include_source_info,omitempty" yaml:"include_source_info,omitempty"`
 		} `json:"plugins,omitempty" yaml:"plugins,omitempty"`
 	} `json:"generate,omitempty" yaml:"generate,omitempty"`
+	Format struct {
+		MaxLineLength      int  `json:"max_line_length,omitempty" yaml:"max_line_length,omitempty"`
+		IndentWidth        int  `json:"indent_width,omitempty" yaml:"indent_width,omitempty"`
+		UseTabs            bool `json:"use_tabs,omitempty" yaml:"use_tabs,omitempty"`
+		AlignFields        bool `json:"align_fields,omitempty" yaml:"align_fields,omitempty"`
+		AlignEnumValues    bool `json:"align_enum_values,omitempty" yaml:"align_enum_values,omitempty"`
+		TopLevelBlankLines int  `json:"top_level_blank_lines,omitempty" yaml:"top_level_blank_lines,omitempty"`
+		CanonicalOrder     bool `json:"canonical_order,omitempty" yaml:"canonical_order,omitempty"`
+		CommentWidth       int  `json:"comment_width,omitempty" yaml:"comment_width,omitempty"`
+	} `json:"format,omitempty" yaml:"format,omitempty"`
 }
 
 // ConfigProvider provides Configs.
@@ -391,3 +556,21 @@ func ConfigProviderWithDevelMode() ConfigProviderOption {
 func NewConfigProvider(options ...ConfigProviderOption) ConfigProvider {
 	return newConfigProvider(options...)
 }
+
+// ExternalLintGroup is the external representation of a user-defined lint group.
+//
+// It is meant to be set by a YAML or JSON config file, or a lint group file
+// referenced from lint.group_files.
+type ExternalLintGroup struct {
+	Name    string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Extends string   `json:"extends,omitempty" yaml:"extends,omitempty"`
+	Add     []string `json:"add,omitempty" yaml:"add,omitempty"`
+	Remove  []string `json:"remove,omitempty" yaml:"remove,omitempty"`
+}
+
+// ExternalLintGroupFile is the external representation of a lint group file.
+//
+// Lint group files allow multiple repositories to share the same lint policy.
+type ExternalLintGroupFile struct {
+	Groups []ExternalLintGroup `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
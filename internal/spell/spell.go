@@ -0,0 +1,166 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package spell checks the spelling of English words.
+package spell
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	englishWordSet     map[string]struct{}
+	englishWordSetOnce sync.Once
+)
+
+// Checker checks the spelling of words.
+type Checker interface {
+	// IsCorrect returns true if the word is spelled correctly.
+	//
+	// Words are compared case-insensitively, and regular plurals and
+	// verb forms of known words such as "messages", "tagged" and
+	// "paginating" are also accepted. Single letters and words that
+	// contain characters other than letters and apostrophes are always
+	// considered correct.
+	IsCorrect(word string) bool
+}
+
+// CheckerOption is an option for a new Checker.
+type CheckerOption func(*checker)
+
+// CheckerWithWords returns a CheckerOption that adds the given words to
+// the bundled English wordlist.
+func CheckerWithWords(words ...string) CheckerOption {
+	return func(checker *checker) {
+		for _, word := range words {
+			checker.extraWordSet[strings.ToLower(word)] = struct{}{}
+		}
+	}
+}
+
+// NewChecker returns a new Checker that uses the bundled English wordlist.
+func NewChecker(options ...CheckerOption) Checker {
+	return newChecker(options...)
+}
+
+// ParseWords parses the data of a dictionary file.
+//
+// Dictionary files have one word per line. Empty lines and lines that
+// begin with # are ignored.
+func ParseWords(data []byte) []string {
+	var words []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		words = append(words, line)
+	}
+	return words
+}
+
+type checker struct {
+	extraWordSet map[string]struct{}
+}
+
+func newChecker(options ...CheckerOption) *checker {
+	englishWordSetOnce.Do(func() {
+		englishWordSet = make(map[string]struct{})
+		for _, word := range ParseWords([]byte(englishWords)) {
+			englishWordSet[word] = struct{}{}
+		}
+	})
+	checker := &checker{
+		extraWordSet: make(map[string]struct{}),
+	}
+	for _, option := range options {
+		option(checker)
+	}
+	return checker
+}
+
+func (c *checker) IsCorrect(word string) bool {
+	word = strings.ToLower(word)
+	if len(word) < 2 {
+		return true
+	}
+	for _, r := range word {
+		if !(('a' <= r && r <= 'z') || r == '\'') {
+			return true
+		}
+	}
+	if c.isKnown(word) {
+		return true
+	}
+	for _, stem := range getStems(word) {
+		if c.isKnown(stem) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *checker) isKnown(word string) bool {
+	if _, ok := englishWordSet[word]; ok {
+		return true
+	}
+	_, ok := c.extraWordSet[word]
+	return ok
+}
+
+// getStems returns the possible stems of a word with a regular plural or
+// verb suffix, for example "tag" for "tags", "tagged" and "tagging".
+func getStems(word string) []string {
+	var stems []string
+	addStem := func(stem string) {
+		if len(stem) >= 2 {
+			stems = append(stems, stem)
+		}
+	}
+	addStemAndUndoubled := func(stem string) {
+		addStem(stem)
+		if n := len(stem); n >= 3 && stem[n-1] == stem[n-2] {
+			addStem(stem[:n-1])
+		}
+	}
+	switch {
+	case strings.HasSuffix(word, "'s"):
+		addStem(strings.TrimSuffix(word, "'s"))
+	case strings.HasSuffix(word, "ies"):
+		addStem(strings.TrimSuffix(word, "ies") + "y")
+	case strings.HasSuffix(word, "es"):
+		addStem(strings.TrimSuffix(word, "es"))
+		addStem(strings.TrimSuffix(word, "s"))
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		addStem(strings.TrimSuffix(word, "s"))
+	case strings.HasSuffix(word, "ied"):
+		addStem(strings.TrimSuffix(word, "ied") + "y")
+	case strings.HasSuffix(word, "ed"):
+		addStemAndUndoubled(strings.TrimSuffix(word, "ed"))
+		addStem(strings.TrimSuffix(word, "d"))
+	case strings.HasSuffix(word, "ing"):
+		addStemAndUndoubled(strings.TrimSuffix(word, "ing"))
+		addStem(strings.TrimSuffix(word, "ing") + "e")
+	case strings.HasSuffix(word, "ly"):
+		addStem(strings.TrimSuffix(word, "ly"))
+	}
+	return stems
+}
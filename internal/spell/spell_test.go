@@ -0,0 +1,66 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spell
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCorrect(t *testing.T) {
+	checker := NewChecker(CheckerWithWords("Prototool"))
+	for _, word := range []string{
+		"message",
+		"Message",
+		"messages",
+		"message's",
+		"tagged",
+		"paginating",
+		"created",
+		"entries",
+		"don't",
+		"prototool",
+		"a",
+		"v1",
+		"foo.bar",
+	} {
+		assert.True(t, checker.IsCorrect(word), word)
+	}
+	for _, word := range []string{
+		"recieve",
+		"recieved",
+		"seperate",
+		"mesage",
+		"feilds",
+		"untill",
+	} {
+		assert.False(t, checker.IsCorrect(word), word)
+	}
+}
+
+func TestParseWords(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"foo", "bar"},
+		ParseWords([]byte("# comment\nfoo\n\n  bar  \n")),
+	)
+}
@@ -0,0 +1,9652 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package spell
+
+// englishWords is the bundled English wordlist, one lowercase word per
+// line, sorted.
+//
+// The list contains the words that are common in the documentation of
+// open source software, and common words from the business domains that
+// APIs model. Words that are specific to a single project belong in the
+// dictionary file of that project, configured with lint.dictionary.path.
+const englishWords = `
+aaa
+aaron
+aas
+abandon
+abandoned
+abbrev
+abbreviate
+abbreviated
+abbreviating
+abbreviation
+abbreviations
+abc
+abcd
+abcdef
+abcdefgh
+abi
+ability
+able
+abnormal
+abnormally
+abort
+aborted
+aborting
+aborts
+about
+above
+abruptly
+abs
+absence
+absent
+absolute
+absolutely
+absorb
+abstract
+abstracted
+abstracting
+abstraction
+abstractions
+abstracts
+absurd
+absurdly
+abuse
+abused
+academic
+acc
+accelerate
+accelerated
+accelerator
+accelerators
+accept
+acceptable
+acceptance
+accepted
+accepting
+accepts
+access
+accessed
+accesses
+accessibility
+accessible
+accessing
+accessor
+accessors
+accessory
+accident
+accidental
+accidentally
+accidents
+accommodate
+accompanied
+accompanying
+accomplish
+accomplished
+accomplishes
+accordance
+according
+accordingly
+account
+accountant
+accounted
+accounting
+accounts
+accrual
+accrue
+accrued
+accumulate
+accumulated
+accumulates
+accumulating
+accumulation
+accumulator
+accuracy
+accurate
+accurately
+achieve
+achieved
+achieves
+ack
+ackermann
+acknowledge
+acknowledged
+acknowledgement
+acknowledges
+acknowledgment
+acm
+acorn
+acos
+acquire
+acquired
+acquires
+acquiring
+acquisition
+across
+act
+acted
+acting
+action
+actions
+activate
+activated
+activates
+activating
+activation
+active
+actively
+activities
+activity
+acts
+actual
+actually
+ad
+ada
+adam
+adamk
+adams
+adapt
+adaptation
+adapted
+adapter
+adapters
+adapting
+adaptive
+adapts
+add
+added
+adding
+addition
+additional
+additionally
+additions
+additive
+addon
+addons
+addr
+address
+addressable
+addressed
+addresses
+addressing
+addrinfo
+adds
+adequate
+adequately
+adhere
+adheres
+adipiscing
+adj
+adjacent
+adjust
+adjusted
+adjusting
+adjustment
+adjustments
+adler
+admin
+administration
+administrative
+administrator
+admins
+admittedly
+admonition
+adobe
+adopted
+adoption
+adrian
+advance
+advanced
+advances
+advancing
+advantage
+advantages
+advertise
+advertised
+advertisement
+advertiser
+advertises
+advertising
+advice
+advise
+advised
+advisory
+affect
+affected
+affecting
+affects
+affiliate
+affine
+affinity
+aforementioned
+afraid
+afresh
+after
+afterward
+afterwards
+again
+against
+age
+agency
+agenda
+agent
+aggregate
+aggregated
+aggregation
+aggressively
+ago
+agree
+agreed
+agreement
+agrees
+ah
+ahead
+aid
+aim
+aimed
+aims
+air
+airline
+airport
+aix
+aka
+akin
+ala
+alan
+alarm
+alas
+albeit
+albert
+album
+alert
+alerting
+alerts
+alessandro
+alex
+alexey
+alg
+algebra
+algo
+algorithm
+algorithmic
+algorithms
+alias
+aliased
+aliases
+aliasing
+align
+aligned
+alignment
+aligns
+alike
+aliqua
+alive
+all
+allen
+alleviate
+alloc
+allocate
+allocated
+allocating
+allocation
+allocations
+allocator
+allotted
+allow
+allowable
+allowance
+allowed
+allowing
+allowlist
+allows
+almost
+alnum
+alone
+along
+alongside
+alpha
+alphabet
+alphabetic
+alphabetical
+alphabetically
+alphanumeric
+alphanumerical
+alphanumerics
+alphas
+already
+alright
+also
+alt
+alter
+altered
+altering
+alternate
+alternately
+alternates
+alternating
+alternation
+alternative
+alternatively
+alternatives
+alters
+although
+altogether
+always
+am
+amazon
+ambient
+ambiguities
+ambiguity
+ambiguous
+amend
+america
+american
+amet
+amiss
+among
+amongst
+amount
+amounts
+amp
+ampersand
+amt
+an
+analogous
+analogously
+analogues
+analogy
+analyse
+analysis
+analytics
+analyze
+analyzed
+analyzer
+analyzers
+analyzes
+analyzing
+ancestor
+ancestors
+anchor
+anchored
+anchors
+ancient
+and
+anders
+anderson
+andre
+andrea
+andreas
+andres
+andrew
+andrey
+android
+andy
+anew
+angle
+angles
+angry
+animation
+anna
+annex
+annotate
+annotated
+annotates
+annotating
+annotation
+annotations
+announce
+announced
+announcement
+annoying
+annual
+anon
+anonymous
+anonymously
+another
+ans
+ansi
+answer
+answered
+answering
+answers
+anthony
+anticipate
+anticipated
+anticipation
+anton
+any
+anybody
+anycast
+anyhow
+anymore
+anyone
+anything
+anytime
+anyway
+anyways
+anywhere
+apache
+apart
+api
+apis
+apostrophe
+apostrophes
+app
+apparent
+apparently
+appeal
+appear
+appearance
+appeared
+appearing
+appears
+append
+appended
+appending
+appendix
+appends
+apple
+applicability
+applicable
+applicant
+application
+applications
+applied
+applies
+apply
+applying
+appname
+appointment
+appreciate
+appreciated
+approach
+approaches
+appropriate
+appropriately
+approval
+approve
+approved
+approver
+approximate
+approximated
+approximately
+approximation
+apps
+apr
+april
+apt
+arabic
+arbitrarily
+arbitrary
+arc
+arch
+architectural
+architecture
+architectures
+archive
+archived
+archiver
+archives
+archiving
+archs
+are
+area
+areas
+aren
+aren't
+arena
+arenas
+arg
+argc
+argparse
+args
+argtypes
+arguably
+argument
+arguments
+argv
+arial
+arise
+arises
+arising
+arithmetic
+arity
+arm
+arming
+armored
+arnold
+around
+arr
+arrange
+arranged
+arrangement
+arranges
+arranging
+array
+arrays
+arrival
+arrive
+arrived
+arrives
+arriving
+arrow
+arrows
+art
+article
+articles
+artifact
+artifacts
+artificial
+artificially
+artist
+artur
+as
+ascending
+ascii
+asia
+aside
+asin
+ask
+asked
+asking
+asks
+asm
+aspect
+aspects
+assemble
+assembled
+assembler
+assembles
+assembling
+assembly
+assert
+asserted
+assertion
+assertions
+asserts
+assess
+asset
+assets
+assign
+assigned
+assignee
+assigning
+assignment
+assignments
+assigns
+assist
+assistance
+assists
+associate
+associated
+associates
+associating
+association
+associative
+assorted
+assume
+assumed
+assumes
+assuming
+assumption
+assumptions
+assure
+assured
+ast
+asterisk
+asymmetric
+async
+asynchronous
+asynchronously
+at
+atan
+atexit
+atext
+atime
+atom
+atomic
+atomically
+atoms
+attach
+attached
+attaches
+attaching
+attachment
+attachments
+attack
+attacker
+attackers
+attacks
+attempt
+attempted
+attempting
+attempts
+attendance
+attendee
+attention
+attr
+attribute
+attributed
+attributes
+attribution
+attrs
+audience
+audio
+audit
+audited
+auditing
+audrey
+aug
+augment
+augmented
+augmenting
+augments
+august
+auth
+authenticate
+authenticated
+authenticates
+authenticating
+authentication
+author
+authoring
+authoritative
+authority
+authorization
+authorize
+authorized
+authorizer
+authors
+auto
+autocomplete
+autocompletion
+autoconf
+autodetect
+autodetected
+autodetection
+autogenerated
+autoload
+automagically
+automake
+automate
+automated
+automates
+automatic
+automatically
+automating
+automation
+automaton
+aux
+auxiliary
+availability
+available
+average
+averages
+avg
+avoid
+avoided
+avoiding
+avoids
+await
+awaitable
+awaited
+awaiting
+awake
+award
+aware
+away
+awful
+awk
+awkward
+awoken
+awry
+axis
+azure
+babel
+back
+backed
+backend
+backends
+background
+backing
+backlog
+backoff
+backport
+backported
+backports
+backreference
+backslash
+backslashed
+backslashes
+backspace
+backtick
+backticks
+backtrace
+backtrack
+backtracking
+backup
+backups
+backward
+backwards
+bad
+badly
+bail
+bailout
+bails
+bake
+baked
+bal
+balance
+balanced
+balancing
+ball
+ban
+band
+bandwidth
+bank
+banking
+banned
+banner
+bar
+barcode
+bare
+barewords
+barf
+barrier
+barriers
+barry
+bars
+base
+based
+basedir
+baseline
+basename
+basenames
+bases
+bash
+basic
+basically
+basics
+basis
+basket
+bastian
+bastien
+batch
+batched
+baz
+bazel
+be
+bear
+bearer
+bearing
+beast
+beat
+became
+because
+becker
+become
+becomes
+becoming
+been
+before
+beforehand
+began
+begin
+beginners
+beginning
+beginnings
+begins
+behalf
+behave
+behaved
+behaves
+behaving
+behavior
+behaviors
+behaviour
+behaviours
+behind
+being
+belief
+believe
+believed
+believes
+bell
+bells
+belong
+belonging
+belongs
+below
+ben
+benchmark
+benchmarking
+benchmarks
+beneath
+beneficiary
+benefit
+benefits
+benjamin
+beq
+berkeley
+berry
+beside
+besides
+bessel
+best
+beta
+better
+between
+beware
+bexp
+beyond
+bgcolor
+bias
+bibliography
+bid
+bidi
+bidirectional
+big
+bigger
+biggest
+bigint
+bigints
+bignum
+bill
+billable
+billed
+billing
+billion
+bin
+binaries
+binary
+bind
+binding
+bindings
+binds
+binomial
+bins
+binutils
+bio
+biography
+birth
+birthdate
+birthday
+bisection
+bit
+bite
+bitfield
+bitfields
+bitmap
+bitmask
+bits
+bitsize
+bitstream
+bitstring
+bitwise
+bizarre
+black
+blacklist
+blacklisted
+blah
+blame
+blamed
+blanchard
+blank
+blanks
+bleichenbacher
+blend
+blessed
+blind
+blinding
+blindly
+blink
+blksize
+bloat
+blob
+block
+blocked
+blocking
+blocks
+blocksize
+blog
+blow
+blowfish
+blowing
+blue
+blunt
+board
+boards
+bob
+bodies
+body
+bogus
+boil
+boilerplate
+boils
+bold
+boldface
+bomb
+bone
+bonus
+book
+booked
+booking
+bookkeeping
+books
+bool
+boolean
+booleans
+bools
+boost
+boot
+bootstrap
+bootstrapped
+bootstrapping
+border
+borders
+boring
+borland
+born
+borrow
+borrowed
+borrower
+borrows
+boss
+boston
+both
+bother
+bothering
+bottleneck
+bottom
+bounce
+bound
+boundaries
+boundary
+bounded
+bounding
+bounds
+bourne
+box
+boxed
+boxes
+brace
+braced
+braces
+bracket
+bracketed
+bracketing
+brackets
+brad
+bradford
+bradley
+branch
+branches
+branching
+brand
+brandon
+braun
+breadth
+break
+breakage
+breakages
+breakdown
+breaking
+breakpoint
+breakpoints
+breaks
+brendan
+brent
+brevity
+brian
+bridge
+brief
+briefly
+bright
+brightness
+bring
+brings
+broad
+broadcast
+broadcasts
+broadly
+broke
+broken
+broker
+brought
+brown
+browse
+browser
+browsers
+browsing
+bruno
+brute
+bsize
+btoa
+bubble
+bubbled
+bubbles
+buck
+bucket
+buckets
+budget
+buf
+buffer
+buffered
+buffering
+buffers
+buflen
+bufsize
+bug
+bugfix
+bugfixes
+buggy
+bugreport
+bugs
+bugzilla
+build
+buildable
+buildbot
+buildbots
+builder
+builders
+building
+builds
+built
+builtin
+builtins
+bulk
+bullet
+bulleted
+bump
+bumped
+bumps
+bunch
+bundle
+bundled
+bundles
+bundling
+burke
+bus
+business
+busy
+but
+button
+buttons
+buy
+buyer
+by
+bypass
+bypassed
+bypasses
+bypassing
+byte
+bytecode
+byteorder
+bytes
+bytestring
+bytestrings
+cache
+cacheable
+cached
+caches
+caching
+cal
+calc
+calculate
+calculated
+calculates
+calculating
+calculation
+calculations
+calendar
+calibration
+california
+call
+callable
+callables
+callback
+callbacks
+called
+callee
+callees
+caller
+callers
+calling
+calloc
+calls
+calvin
+cambridge
+came
+camel
+campaign
+can
+can't
+cancel
+canceled
+cancellation
+cancelled
+cancelling
+cancels
+candidate
+candidates
+canned
+cannot
+canon
+canonical
+canonicalization
+canonicalize
+canonicalized
+canonicalizes
+canonically
+canvas
+cap
+capabilities
+capability
+capable
+capacity
+capital
+capitalization
+capitalize
+capitalized
+capitalizing
+caps
+caption
+capture
+captured
+captures
+capturing
+car
+carbon
+card
+cardholder
+care
+career
+careful
+carefully
+careless
+cares
+caret
+carriage
+carried
+carrier
+carries
+carry
+carrying
+cart
+cascading
+case
+cased
+cases
+casey
+cash
+cashback
+casing
+cast
+casted
+casting
+casts
+casual
+cat
+catalog
+catalogs
+catalogue
+catch
+catchall
+catches
+catching
+categories
+categorize
+categorized
+category
+caught
+cause
+caused
+causes
+causing
+caution
+cautious
+caveat
+caveats
+cdata
+cdrom
+cease
+ceil
+ceiling
+cell
+cellpadding
+cells
+cellspacing
+center
+centered
+central
+centre
+century
+cert
+certain
+certainly
+certainty
+certfile
+certificate
+certificates
+certs
+cfile
+cflags
+cgi
+chad
+chain
+chained
+chaining
+chains
+challenge
+challenges
+chance
+chances
+chang
+change
+changed
+changelog
+changelogs
+changes
+changeset
+changing
+channel
+channels
+chapter
+char
+character
+characteristics
+characters
+charge
+charged
+charles
+charmap
+chars
+charset
+charsets
+chart
+chat
+chdir
+cheap
+cheaper
+cheat
+check
+checkbox
+checked
+checker
+checkers
+checkin
+checking
+checkout
+checkpoint
+checks
+checksum
+checksums
+cheng
+cherokee
+cherry
+chia
+chicken
+child
+children
+childs
+china
+chinese
+chip
+chips
+chmod
+chocolate
+choice
+choices
+choke
+choose
+chooses
+choosing
+chop
+chopped
+chopping
+chose
+chosen
+chown
+chris
+christian
+christoph
+christopher
+chrome
+chronological
+chroot
+chuck
+chunk
+chunked
+chunking
+chunks
+churn
+cid
+cipher
+ciphers
+ciphertext
+circle
+circuit
+circular
+circumstance
+circumstances
+circumvent
+city
+claim
+claimed
+claims
+clamp
+clamped
+clang
+clarification
+clarifications
+clarified
+clarifies
+clarify
+clarity
+clark
+clash
+clashes
+clashing
+class
+classes
+classic
+classification
+classified
+classifies
+classify
+classname
+clause
+clauses
+clean
+cleaned
+cleaner
+cleaning
+cleanly
+cleans
+cleanup
+cleanups
+clear
+clearance
+cleared
+clearer
+clearing
+clearly
+clears
+clever
+cli
+click
+clicked
+client
+clients
+clinic
+clinton
+clip
+clobber
+clobbered
+clobbering
+clobbers
+clock
+clocks
+clone
+cloned
+clones
+cloning
+close
+closed
+closely
+closer
+closes
+closest
+closing
+closure
+closures
+cloud
+clue
+clues
+cluster
+clusters
+clutter
+cmdline
+coalesced
+coalescing
+coarse
+code
+codebase
+codeblock
+codec
+codecs
+coded
+codename
+codepage
+codepath
+codepoint
+codepoints
+codes
+coding
+coeff
+coefficient
+coefficients
+coerce
+coerced
+coerces
+coercion
+coffee
+cohen
+coherent
+coin
+coincide
+coincidence
+col
+cold
+colin
+collaborator
+collapse
+collapsed
+collapsing
+collateral
+collect
+collected
+collecting
+collection
+collections
+collectively
+collector
+collects
+collide
+collision
+collisions
+colon
+colons
+color
+colored
+colorful
+coloring
+colorize
+colorized
+colors
+colour
+coloured
+colours
+colspan
+column
+columns
+com
+combination
+combinations
+combine
+combined
+combines
+combining
+combo
+come
+comes
+comfortable
+coming
+comm
+comma
+command
+commandline
+commands
+commas
+comment
+commentary
+commented
+commenting
+comments
+commerce
+commercial
+commission
+commit
+commitment
+commits
+committed
+committer
+common
+commonly
+communicate
+communicates
+communicating
+communication
+communications
+community
+commutative
+comp
+compact
+compactly
+companion
+company
+comparable
+comparatively
+compare
+compared
+compares
+comparing
+comparison
+comparisons
+compat
+compatibility
+compatible
+compensate
+compensated
+compensates
+compensation
+compete
+competing
+compilation
+compilations
+compile
+compiled
+compiler
+compilers
+compiles
+compiling
+complain
+complained
+complaining
+complains
+complaint
+complaints
+complement
+complementary
+complete
+completed
+completely
+completeness
+completer
+completes
+completing
+completion
+completions
+complex
+complexity
+compliance
+compliant
+complicate
+complicated
+complicates
+complication
+complications
+complies
+comply
+component
+components
+compose
+composed
+composing
+composite
+composition
+compound
+comprehensive
+compress
+compressed
+compresses
+compressing
+compression
+compressor
+comprise
+comprised
+comprises
+comprising
+compromise
+compromised
+compton
+computation
+computations
+compute
+computed
+computer
+computers
+computes
+computing
+concat
+concatenate
+concatenated
+concatenates
+concatenating
+concatenation
+concats
+conceal
+conceivable
+conceivably
+concept
+concepts
+conceptually
+concern
+concerned
+concerning
+concerns
+concert
+concise
+concisely
+conclude
+concludes
+conclusion
+concrete
+concretely
+concurrency
+concurrent
+concurrently
+cond
+condensed
+condition
+conditional
+conditionally
+conditionals
+conditions
+conf
+conference
+confidence
+confident
+confidential
+config
+configparser
+configs
+configurable
+configuration
+configurations
+configure
+configured
+configures
+configuring
+confirm
+confirmation
+confirmed
+confirms
+conflict
+conflicting
+conflicts
+conform
+conformance
+conformant
+conforming
+conforms
+confuse
+confused
+confuses
+confusing
+confusingly
+confusion
+congratulations
+conjunction
+conn
+connect
+connected
+connecting
+connection
+connections
+connectivity
+connector
+connects
+cons
+consecutive
+consensus
+consent
+consequence
+consequences
+consequently
+conservative
+conservatively
+consider
+considerable
+considerably
+consideration
+considerations
+considered
+considering
+considers
+consignment
+consist
+consistency
+consistent
+consistently
+consisting
+consists
+console
+consortium
+const
+constant
+constantly
+constants
+constituent
+constitute
+constitutes
+constrain
+constrained
+constrains
+constraint
+constraints
+construct
+constructed
+constructing
+construction
+constructions
+constructor
+constructors
+constructs
+construed
+consult
+consulted
+consulting
+consults
+consume
+consumed
+consumer
+consumers
+consumes
+consuming
+consumption
+cont
+contact
+contacted
+contacting
+contacts
+contain
+contained
+container
+containers
+containing
+containment
+contains
+content
+contention
+contents
+context
+contexts
+contextual
+contiguous
+continent
+continual
+continually
+continuation
+continuations
+continue
+continued
+continues
+continuing
+continuous
+contract
+contractor
+contradicts
+contrary
+contrast
+contrib
+contribute
+contributed
+contributing
+contribution
+contributions
+contributor
+contributors
+contrived
+control
+controllable
+controlled
+controller
+controllers
+controlling
+controls
+controversial
+conv
+convenience
+convenient
+conveniently
+convention
+conventional
+conventionally
+conventions
+convergence
+conversation
+conversely
+conversion
+conversions
+convert
+converted
+converter
+converters
+converting
+converts
+convey
+conveyed
+convoluted
+cook
+cookbook
+cooked
+cookie
+cookiejar
+cookies
+cool
+cooperation
+coordinate
+coordinated
+coordinates
+coordinating
+coordination
+cope
+copied
+copies
+copy
+copying
+copyright
+copyrighted
+core
+coredump
+cores
+corner
+coroutine
+coroutines
+corporate
+corporation
+corpus
+correct
+corrected
+correcting
+correction
+corrections
+correctly
+correctness
+corrects
+correlate
+correlation
+correspond
+correspondence
+corresponding
+correspondingly
+corresponds
+corrupt
+corrupted
+corruption
+corruptions
+corrupts
+cos
+cosh
+cosine
+cost
+costly
+costs
+could
+couldn
+couldn't
+council
+count
+counted
+counter
+counterintuitive
+counterpart
+counterparts
+counters
+counting
+countries
+country
+counts
+couple
+coupled
+coupling
+coupon
+courier
+course
+courtesy
+cousin
+covariant
+cover
+coverage
+coverdir
+covered
+covering
+covers
+cpu
+crack
+craft
+crafted
+craig
+crash
+crashed
+crashes
+crashing
+crazy
+create
+created
+creates
+creating
+creation
+creative
+creator
+credential
+credentials
+credit
+credited
+creditor
+credits
+cribbed
+criteria
+criterion
+critical
+cron
+cross
+crossed
+crosses
+crossing
+crowd
+crucial
+crucially
+crude
+cruft
+crypt
+cryptic
+crypto
+cryptographic
+cryptographically
+cryptography
+ctags
+ctime
+ctor
+ctype
+cube
+cull
+cumbersome
+cumulative
+cur
+curated
+curious
+curl
+curlies
+curly
+currency
+current
+currently
+cursor
+curve
+curves
+custom
+customary
+customer
+customers
+customizable
+customization
+customizations
+customize
+customized
+customizing
+cut
+cute
+cutoff
+cuts
+cutting
+cyan
+cycle
+cycles
+cyclic
+cycling
+cygwin
+cyrillic
+daemon
+daemons
+daily
+damage
+damages
+damian
+dan
+dance
+danger
+dangerous
+dangling
+daniel
+dark
+darker
+darren
+dart
+darwin
+dash
+dashboard
+dashes
+data
+database
+databases
+datagram
+dataset
+datatype
+datatypes
+date
+dates
+datetime
+datum
+dave
+david
+davis
+day
+daylight
+days
+dbus
+deactivate
+dead
+deadline
+deadlock
+deadlocks
+deal
+dealer
+dealing
+deallocated
+deallocates
+deallocation
+deals
+dealt
+dean
+death
+deb
+debhelper
+debian
+debit
+debt
+debtor
+debug
+debuggability
+debugged
+debugger
+debuggers
+debugging
+dec
+december
+decent
+decide
+decided
+decides
+deciding
+decimal
+decimals
+decision
+decisions
+decl
+declaration
+declarations
+declarative
+declare
+declared
+declares
+declaring
+decline
+declined
+decodable
+decode
+decoded
+decoder
+decoders
+decodes
+decoding
+decompose
+decomposed
+decomposition
+decompress
+decompressed
+decompresses
+decompression
+decompressor
+decorate
+decorated
+decorating
+decoration
+decouple
+decrease
+decreased
+decreases
+decreasing
+decrement
+decremented
+decrementing
+decrements
+decrypt
+decrypted
+decrypting
+decryption
+decrypts
+dedicated
+deduce
+dedup
+deduplicate
+deduplicated
+deemed
+deems
+deep
+deepcopy
+deeper
+deepest
+deeply
+def
+defacto
+default
+defaulted
+defaulting
+defaults
+defeat
+defeats
+defect
+defects
+defensive
+defensively
+defer
+deference
+deferred
+deferring
+defers
+define
+defined
+defines
+defining
+definitely
+definition
+definitions
+definitive
+deflate
+deflated
+deflating
+deflation
+defn
+defs
+defunct
+deg
+degenerate
+degradation
+degrade
+degree
+degrees
+del
+delay
+delayed
+delaying
+delays
+delegate
+delegated
+delegates
+delegating
+delete
+deleted
+deletes
+deleting
+deletion
+deletions
+deliberate
+deliberately
+delicate
+delim
+delimit
+delimited
+delimiter
+delimiters
+delimiting
+delims
+deliver
+delivered
+delivers
+delivery
+delta
+deltas
+delve
+demand
+demands
+demangle
+demo
+demonstrate
+demonstrated
+demonstrates
+demonstrating
+demonstration
+denial
+denied
+dennis
+denom
+denominator
+denormals
+denote
+denoted
+denotes
+denoting
+density
+deny
+denylist
+dep
+department
+departure
+depend
+depended
+dependence
+dependencies
+dependency
+dependent
+depending
+depends
+deployed
+deploying
+deployment
+deployments
+deposit
+depot
+deprecate
+deprecated
+deprecating
+deprecation
+deprecations
+deps
+depth
+depths
+deque
+dequeue
+der
+deref
+dereference
+dereferenced
+dereferencing
+derivation
+derivative
+derivatives
+derive
+derived
+derives
+deriving
+desc
+descend
+descendant
+descendants
+descended
+descendents
+descending
+descends
+descent
+describe
+described
+describes
+describing
+description
+descriptions
+descriptive
+descriptor
+descriptors
+deserialization
+deserialize
+deserialized
+deserializes
+deserializing
+design
+designate
+designated
+designation
+designed
+designers
+desirable
+desire
+desired
+desires
+desktop
+despite
+dest
+destination
+destinations
+destroy
+destroyed
+destroying
+destroys
+destruction
+destructive
+destructor
+destructors
+detach
+detached
+detail
+detailed
+details
+detect
+detectable
+detected
+detecting
+detection
+detector
+detects
+determination
+determine
+determined
+determines
+determining
+determinism
+deterministic
+deterministically
+dev
+devanagari
+devel
+develop
+developed
+developer
+developers
+developing
+development
+deviates
+deviation
+deviations
+device
+devices
+devmajor
+devminor
+devnull
+devoted
+diag
+diagnose
+diagnosed
+diagnosing
+diagnosis
+diagnostic
+diagnostics
+diagonal
+diagram
+diags
+dial
+dialect
+dialects
+dialog
+dialogue
+diamond
+dice
+dict
+dictate
+dictates
+dictionaries
+dictionary
+dicts
+did
+didn
+didn't
+die
+died
+dies
+diff
+differ
+difference
+differences
+different
+differentiate
+differentiates
+differentiating
+differentiation
+differently
+differing
+differs
+difficult
+difficulties
+difficulty
+diffie
+diffs
+dig
+digest
+digests
+digging
+digit
+digital
+digits
+dim
+dimension
+dimensions
+dir
+direct
+directed
+direction
+directionality
+directions
+directive
+directives
+directly
+director
+directories
+directory
+directs
+dirent
+dirfd
+dirname
+dirs
+dirty
+disable
+disabled
+disables
+disabling
+disadvantage
+disagree
+disagrees
+disallow
+disallowed
+disallowing
+disallows
+disambiguate
+disambiguating
+disambiguation
+disappear
+disappeared
+disappears
+disassemble
+disassembled
+disassembly
+disassociate
+disastrous
+disc
+discard
+discarded
+discarding
+discards
+discernible
+discipline
+disclaimer
+disconnect
+disconnected
+disconnecting
+disconnection
+disconnects
+discontiguous
+discount
+discounted
+discourage
+discouraged
+discover
+discovered
+discovering
+discovers
+discovery
+discrepancies
+discrepancy
+discrete
+discretion
+discriminate
+discriminates
+discuss
+discussed
+discussion
+discussions
+disk
+disks
+dispatch
+dispatched
+dispatcher
+dispatches
+dispatching
+display
+displayed
+displaying
+displays
+disposal
+dispose
+disposed
+disposition
+dispositions
+dispute
+disregard
+disrupt
+disruptive
+dist
+distance
+distances
+distant
+distcheck
+distclean
+distinct
+distinction
+distinctions
+distinctive
+distinguish
+distinguishable
+distinguished
+distinguishes
+distinguishing
+distracting
+distribute
+distributed
+distributing
+distribution
+distributions
+distributor
+distributors
+district
+distro
+distros
+dists
+disturb
+distutils
+ditch
+ditto
+div
+diverged
+diversity
+divide
+divided
+dividend
+divider
+divides
+dividing
+divisible
+division
+divisions
+divisor
+django
+dlopen
+do
+doc
+docker
+docs
+docstrings
+doctype
+document
+documentation
+documented
+documenting
+documents
+docutils
+does
+doesn
+doesn't
+doing
+dollar
+dolor
+dolore
+dom
+domain
+domainname
+domains
+dominant
+dominated
+dominating
+don
+don't
+donation
+done
+dos
+dot
+dotless
+dots
+dotted
+double
+doubles
+doubling
+doubly
+doubt
+doug
+douglas
+down
+downgrade
+downgraded
+downgrades
+downgrading
+download
+downloaded
+downloading
+downloads
+downside
+downsides
+downstream
+downwards
+dozen
+draft
+dragonfly
+drain
+drained
+draining
+drains
+drake
+dramatically
+drastically
+draw
+drawback
+drawing
+drawn
+draws
+drift
+drive
+driven
+driver
+drivers
+drives
+drop
+dropoff
+dropped
+dropping
+drops
+dry
+dtrace
+dual
+dubious
+duck
+due
+duh
+dumb
+dummy
+dump
+dumped
+dumper
+dumping
+dumps
+duncan
+dup
+duplex
+duplicate
+duplicated
+duplicates
+duplicating
+duplication
+duration
+durations
+during
+duties
+dying
+dylan
+dynamic
+dynamically
+each
+eager
+eagerly
+ear
+earlier
+earliest
+early
+earning
+earnings
+ease
+eases
+easier
+easiest
+easily
+east
+easy
+eat
+eats
+echo
+echoed
+echoing
+ecosystem
+edge
+edges
+edit
+editable
+edited
+editing
+edition
+editor
+editors
+edits
+educated
+education
+edward
+effect
+effected
+effective
+effectively
+effectiveness
+effects
+efficiency
+efficient
+efficiently
+effort
+efforts
+egg
+egrep
+eid
+eight
+eighth
+either
+eiusmod
+elaborate
+elapsed
+election
+electronic
+elegant
+elem
+element
+elementary
+elements
+elevated
+elf
+elide
+elided
+elif
+eligibility
+eligible
+eliminate
+eliminated
+eliminates
+eliminating
+elimination
+elit
+ellipses
+ellipsis
+elliptic
+elp
+else
+elseif
+elsewhere
+elts
+emacs
+email
+emails
+embed
+embedded
+embedders
+embedding
+emergency
+emission
+emit
+emits
+emitted
+emitter
+emitting
+emoji
+emphasis
+empirical
+empirically
+employ
+employed
+employee
+employer
+employment
+emptied
+empties
+emptiness
+empty
+emscripten
+emulate
+emulated
+emulates
+emulating
+emulation
+emulator
+emulators
+enable
+enabled
+enables
+enabling
+enc
+encapsulate
+encapsulated
+encapsulates
+encapsulation
+encipher
+enclose
+enclosed
+enclosing
+encodable
+encode
+encoded
+encoder
+encoders
+encodes
+encoding
+encodings
+encounter
+encountered
+encountering
+encounters
+encourage
+encouraged
+encourages
+encrypt
+encrypted
+encrypting
+encryption
+encrypts
+end
+ended
+endian
+endianness
+endif
+ending
+endings
+endless
+endlessly
+endorse
+endpoint
+endpoints
+ends
+energy
+enforce
+enforced
+enforces
+enforcing
+engagement
+engine
+engineer
+engineering
+engines
+english
+enhance
+enhanced
+enhancements
+enhances
+enjoy
+enormous
+enough
+enqueue
+enqueued
+enqueuing
+enrich
+enrollment
+enrolment
+ensure
+ensured
+ensurepip
+ensures
+ensuring
+entails
+enter
+entered
+entering
+enterprise
+enters
+entire
+entirely
+entirety
+entities
+entitlement
+entity
+entries
+entropy
+entry
+entrypoint
+entrypoints
+enum
+enumerable
+enumerate
+enumerated
+enumeration
+enumerations
+enums
+env
+envelope
+environ
+environment
+environmental
+environments
+eof
+eol
+ephemeral
+epilog
+epilogue
+epoch
+epoll
+equal
+equalities
+equality
+equally
+equals
+equation
+equipment
+equivalence
+equivalent
+equivalently
+equivalents
+erase
+erased
+erases
+erasing
+eric
+err
+errata
+errcode
+errmsg
+errno
+erroneous
+erroneously
+error
+erroring
+errors
+errs
+errstr
+esc
+escalate
+escalation
+escape
+escaped
+escapes
+escaping
+esoteric
+esp
+especially
+essential
+essentially
+establish
+established
+establishes
+establishing
+estimate
+estimated
+estimates
+estimation
+etag
+etc
+ethernet
+etype
+euc
+euler
+euro
+europe
+european
+eval
+evaluate
+evaluated
+evaluates
+evaluating
+evaluation
+evans
+even
+evenly
+event
+events
+eventual
+eventually
+ever
+every
+everybody
+everyday
+everyone
+everything
+everywhere
+evict
+evicted
+evidence
+evident
+evidently
+evil
+evolution
+evolve
+evolved
+evolves
+evolving
+exact
+exactly
+exactness
+examination
+examine
+examined
+examines
+examining
+example
+examples
+exceed
+exceeded
+exceeds
+excellent
+except
+exception
+exceptional
+exceptions
+excess
+excessive
+excessively
+exchange
+exclamation
+exclude
+excluded
+excludes
+excluding
+exclusion
+exclusions
+exclusive
+exclusively
+exe
+exec
+executable
+executables
+execute
+executed
+executes
+executing
+execution
+executions
+executor
+exempt
+exemption
+exercise
+exercised
+exhaust
+exhausted
+exhaustion
+exhaustive
+exhaustively
+exhausts
+exhibit
+exist
+existed
+existence
+existing
+exists
+exit
+exitcode
+exited
+exiting
+exits
+exotic
+exp
+expand
+expanded
+expander
+expanding
+expands
+expandtab
+expansion
+expansions
+expat
+expect
+expectation
+expectations
+expected
+expecting
+expects
+expense
+expensive
+experience
+experienced
+experiment
+experimental
+experimentally
+experimentation
+experimenting
+experiments
+expiration
+expire
+expired
+expires
+expiring
+expiry
+explain
+explained
+explaining
+explains
+explanation
+explanations
+explanatory
+explicit
+explicitly
+explode
+exploit
+exploited
+exploration
+explore
+explorer
+exploring
+explosion
+exponent
+exponential
+exponentially
+exponentiation
+exponents
+export
+exportable
+exported
+exporter
+exporting
+exports
+expose
+exposed
+exposes
+exposing
+exposure
+expr
+express
+expressed
+expressible
+expressing
+expression
+expressions
+exprs
+ext
+extant
+extend
+extendable
+extended
+extending
+extends
+extensibility
+extensible
+extension
+extensions
+extensive
+extensively
+extent
+extern
+external
+externally
+extra
+extract
+extracted
+extracting
+extraction
+extractions
+extracts
+extraneous
+extras
+extreme
+extremely
+eye
+eyeballs
+eyes
+face
+faced
+facilitate
+facilitates
+facilities
+facility
+facing
+fact
+facto
+factor
+factored
+factories
+factoring
+factorization
+factors
+factory
+facts
+fail
+failed
+failing
+fails
+failure
+failures
+fair
+fairly
+fairness
+faith
+faithful
+faithfully
+fake
+faked
+fall
+fallback
+fallbacks
+falling
+falls
+fallthrough
+false
+falsey
+familiar
+families
+family
+fan
+fancier
+fancy
+far
+fare
+farm
+farrell
+farther
+fashion
+fast
+faster
+fastest
+fat
+fatal
+fault
+faults
+faulty
+favor
+favorite
+favourite
+fax
+fchdir
+fdopen
+fdrake
+fear
+feasible
+feature
+features
+feb
+february
+fed
+fedora
+fee
+feed
+feedback
+feeding
+feeds
+feel
+feeling
+feels
+felix
+fell
+fence
+fenced
+fenwick
+ferreira
+fetch
+fetched
+fetches
+fetching
+few
+fewer
+ffi
+fib
+fibonacci
+fiction
+field
+fields
+fifo
+fifth
+figure
+figured
+figures
+figuring
+file
+filed
+filehandle
+filehandles
+filename
+filenames
+fileno
+filepath
+filepaths
+files
+fileset
+filesize
+filesystem
+filesystems
+filetest
+filetype
+fill
+filled
+filler
+filling
+fills
+film
+filt
+filter
+filtered
+filtering
+filters
+final
+finalization
+finalize
+finalized
+finalizer
+finalizers
+finalizes
+finalizing
+finally
+finance
+financial
+find
+findable
+finder
+finding
+findings
+finds
+fine
+finer
+fingerprint
+fingerprints
+finish
+finished
+finishes
+finishing
+finite
+fire
+fired
+firefox
+firewall
+firing
+firmware
+first
+firstly
+fiscal
+fish
+fisher
+fit
+fits
+fitting
+five
+fix
+fixable
+fixed
+fixer
+fixers
+fixes
+fixing
+fixme
+fixture
+fixtures
+fixup
+flag
+flagged
+flags
+flat
+flatten
+flattened
+flattening
+flattens
+flavor
+flavors
+flavour
+flavours
+flawed
+flaws
+fleet
+fletcher
+flexibility
+flexible
+flexibly
+flight
+flip
+flipping
+float
+floating
+floats
+flock
+flood
+flooding
+floor
+floppy
+florian
+flow
+flows
+flush
+flushed
+flushes
+flushing
+fly
+fname
+fnmatch
+focus
+focused
+focuses
+fold
+folded
+folder
+folders
+folding
+folk
+folks
+follow
+followed
+follower
+following
+follows
+font
+fonts
+foo
+foobar
+food
+fooled
+foot
+footer
+footers
+footnote
+footnotes
+footprint
+fopen
+for
+forbid
+forbidden
+forbids
+force
+forced
+forcefully
+forces
+forcibly
+forcing
+foreach
+forecast
+foreground
+foreign
+forever
+forgery
+forget
+forgiving
+forgot
+forgotten
+fork
+forked
+forking
+forks
+form
+formal
+formalize
+formalized
+formally
+format
+formats
+formatted
+formatter
+formatters
+formatting
+formed
+former
+formerly
+formfeed
+forming
+forms
+formula
+formulas
+forth
+fortran
+fortunately
+forum
+forward
+forwarded
+forwarding
+forwards
+found
+foundation
+four
+fourth
+fowler
+fprintf
+frac
+fraction
+fractional
+fractions
+fragile
+fragment
+fragmentation
+fragments
+frame
+framed
+frames
+framework
+frameworks
+framing
+fran
+francois
+frank
+franklin
+fraud
+fread
+fred
+free
+freebsd
+freed
+freedesktop
+freedom
+freeing
+freely
+frees
+freeze
+freezes
+freezing
+french
+frequencies
+frequency
+frequent
+frequently
+fresh
+freshly
+fri
+friday
+friend
+friendlier
+friendly
+friends
+frivolously
+from
+front
+frontend
+frontends
+frozen
+fset
+fstat
+ftruncate
+fudge
+fuel
+fulfill
+fulfilled
+fulfilling
+fulfillment
+fulfills
+fulfilment
+full
+fuller
+fullname
+fully
+fun
+func
+funcdef
+funcname
+funcs
+function
+functional
+functionalities
+functionality
+functionally
+functioning
+functions
+functools
+fund
+fundamental
+fundamentally
+funding
+funds
+funky
+funny
+furnished
+further
+furthermore
+fused
+futile
+future
+fuzzy
+gailly
+gain
+gained
+gaining
+gains
+gallery
+game
+games
+gamma
+gang
+gap
+gaps
+garbage
+garcia
+gareth
+gas
+gate
+gateway
+gather
+gathered
+gathers
+gave
+geared
+gee
+gen
+gender
+general
+generality
+generalize
+generalized
+generalizes
+generally
+generate
+generated
+generates
+generating
+generation
+generations
+generator
+generators
+generic
+generically
+generics
+generous
+gentle
+genuine
+geo
+geographical
+geography
+geolocation
+geometric
+george
+gerhard
+german
+gerrit
+get
+getaddrinfo
+getattr
+getc
+getcwd
+getegid
+getenv
+geteuid
+getgid
+gethostname
+getlines
+getopt
+getpeername
+getppid
+getpwuid
+gets
+getsockname
+getsockopt
+getter
+getters
+gettext
+getting
+getuid
+gid
+gids
+gif
+gift
+gigabytes
+gil
+gisle
+git
+github
+give
+given
+gives
+giving
+glen
+glenn
+glib
+glibc
+glitches
+glob
+global
+globally
+globals
+globbing
+globs
+glossary
+glue
+glyph
+gmail
+gmake
+gmtime
+gname
+gnome
+gnu
+gnupg
+go
+goal
+goals
+gobble
+goes
+going
+gold
+golden
+gone
+good
+goodbye
+goodies
+goods
+google
+gordon
+gory
+got
+goto
+gotos
+gotten
+govern
+governed
+governing
+government
+governs
+grab
+grabbed
+grabs
+graceful
+gracefully
+grade
+gradual
+gradually
+graham
+grain
+grained
+grammar
+grammars
+grammatical
+grand
+grant
+granted
+grants
+granular
+granularity
+graph
+graphical
+graphics
+graphs
+graphviz
+grayscale
+great
+greater
+greatest
+greatly
+greedy
+greek
+green
+greet
+greeting
+greg
+gregor
+gregorian
+gregory
+grep
+grew
+grey
+greyed
+grid
+groff
+grok
+groks
+gross
+ground
+group
+grouped
+grouping
+groupings
+groups
+grow
+growable
+growing
+grown
+grows
+growth
+gtest
+guarantee
+guaranteed
+guarantees
+guard
+guarded
+guarding
+guards
+guess
+guessed
+guesses
+guessing
+guest
+gui
+guid
+guidance
+guide
+guideline
+guidelines
+guides
+guido
+gunzip
+gustavo
+guts
+guy
+gzip
+gzipped
+habits
+hack
+hacked
+hackery
+hacking
+hackish
+hacks
+hacky
+had
+hadn
+hadn't
+hairy
+half
+halfway
+halt
+halts
+halves
+hammond
+han
+hand
+handbook
+handed
+handful
+handier
+handing
+handle
+handled
+handler
+handlers
+handles
+handling
+hands
+handshake
+handy
+hang
+hanging
+hangs
+hangul
+hans
+happen
+happened
+happening
+happens
+happily
+happy
+harald
+hard
+hardcode
+hardcoded
+hardening
+harder
+hardest
+hardlink
+hardlinks
+hardly
+hardware
+hardwired
+harm
+harmful
+harmless
+harness
+harnesses
+harris
+has
+hash
+hashable
+hashed
+hasher
+hashes
+hashing
+hashlib
+hasn
+hasn't
+hat
+hatch
+have
+haven
+haven't
+having
+hazardous
+he
+head
+headed
+header
+headers
+heading
+headings
+heads
+health
+heap
+heapify
+heaps
+hear
+heard
+heart
+heated
+heavily
+heavy
+hebrew
+heck
+height
+heights
+held
+hell
+hello
+help
+helped
+helper
+helpers
+helpful
+helpfully
+helping
+helps
+hence
+henry
+her
+here
+hereby
+herein
+hess
+heuristic
+heuristically
+heuristics
+hex
+hexadecimal
+hexadecimals
+hexdigits
+hexdump
+hey
+hi
+hid
+hidden
+hide
+hides
+hiding
+hierarchical
+hierarchies
+hierarchy
+high
+higher
+highest
+highlight
+highlighted
+highlighter
+highlighting
+highlights
+highly
+him
+hint
+hints
+hiragana
+his
+historic
+historical
+historically
+history
+hit
+hits
+hitting
+hmac
+hoc
+hog
+hold
+holder
+holders
+holding
+holds
+hole
+holes
+holiday
+holland
+home
+homebrew
+homepage
+hong
+honor
+honored
+honoring
+honors
+honour
+honours
+hood
+hook
+hooks
+hop
+hope
+hoped
+hopefully
+hoping
+horizontal
+horizontally
+horrible
+horribly
+host
+hosted
+hostent
+hostname
+hostnames
+hosts
+hot
+hotel
+hotfix
+hottest
+hour
+hourly
+hours
+house
+household
+how
+however
+href
+html
+http
+https
+hub
+hudson
+huffman
+huge
+hughes
+hugo
+human
+humans
+hundred
+hundreds
+hunk
+hunks
+hurd
+hurt
+hurts
+hybrid
+hybrids
+hyper
+hyperbolic
+hyperlinks
+hyphen
+hyphenated
+hyphens
+hypothetical
+hyrum
+ian
+ibm
+icmp
+icon
+id
+idea
+ideal
+ideally
+ideas
+idempotency
+idempotent
+ident
+identical
+identically
+identifiable
+identification
+identified
+identifier
+identifiers
+identifies
+identify
+identifying
+identities
+identity
+idiom
+idiomatic
+idioms
+idle
+ids
+idtype
+idx
+ies
+if
+ifdef
+iff
+ifndef
+ignorable
+ignore
+ignored
+ignores
+ignoring
+igor
+iki
+ill
+illegal
+illustrate
+illustrated
+illustrates
+illustrating
+illustration
+ilya
+imag
+image
+images
+imaginary
+imagine
+imap
+imitate
+imitating
+immediate
+immediately
+immediates
+imminent
+immune
+immutable
+imp
+impact
+impacted
+imperative
+imperfect
+impl
+implement
+implementation
+implementations
+implemented
+implementers
+implementing
+implementors
+implements
+implication
+implications
+implicit
+implicitly
+implicits
+implied
+implies
+imply
+implying
+import
+importable
+importance
+important
+importantly
+imported
+importer
+importers
+importing
+imports
+impose
+imposed
+impossible
+imprecise
+imprecision
+impression
+improperly
+improve
+improved
+improvement
+improvements
+improves
+improving
+in
+inability
+inaccessible
+inaccurate
+inactive
+inactivity
+inadequate
+inadvertently
+inappropriate
+inappropriately
+inbound
+inc
+incantation
+incapable
+incident
+incidental
+incidentally
+incididunt
+incl
+include
+included
+includes
+including
+inclusion
+inclusions
+inclusive
+income
+incoming
+incompatibilities
+incompatibility
+incompatible
+incompatibly
+incomplete
+inconsistencies
+inconsistency
+inconsistent
+inconsistently
+inconvenience
+inconvenient
+incorporate
+incorporated
+incorporates
+incorporating
+incorrect
+incorrectly
+increase
+increased
+increases
+increasing
+increasingly
+incredibly
+increment
+incremental
+incrementally
+incremented
+incrementing
+increments
+incur
+incurs
+ind
+indeed
+indefinitely
+indent
+indentation
+indented
+indenting
+indents
+independent
+independently
+indeterminate
+index
+indexed
+indexer
+indexes
+indexing
+indicate
+indicated
+indicates
+indicating
+indication
+indicative
+indicator
+indicators
+indices
+indirect
+indirection
+indirectly
+indiscriminately
+indistinguishable
+individual
+individually
+individuals
+indivisible
+induce
+induced
+industry
+ineffective
+inefficient
+inequalities
+inequality
+inet
+inevitable
+inevitably
+inexact
+inf
+infd
+infer
+inference
+inferior
+inferno
+inferred
+infers
+infile
+infinite
+infinitely
+infinities
+infinitum
+infinity
+infix
+inflate
+inflated
+influence
+influenced
+influences
+info
+inform
+informal
+informally
+information
+informational
+informations
+informative
+informed
+informing
+informs
+infos
+infrastructure
+infrequently
+infs
+ing
+ingestion
+inherent
+inherently
+inherit
+inheritable
+inheritance
+inherited
+inheriting
+inherits
+inhibit
+init
+initial
+initialisation
+initialise
+initialised
+initialization
+initializations
+initialize
+initialized
+initializer
+initializers
+initializes
+initializing
+initially
+initiate
+initiated
+initiates
+initiating
+initiative
+inits
+inject
+injected
+injecting
+injection
+injects
+inlinable
+inline
+inlined
+inlining
+innards
+inner
+innermost
+ino
+inode
+inodes
+inplace
+input
+inputs
+inquiries
+inquiry
+insane
+insecure
+insensitive
+insensitively
+insert
+inserted
+inserting
+insertion
+insertions
+inserts
+inside
+insight
+insignificant
+insist
+insisted
+insisting
+insists
+insofar
+inspect
+inspected
+inspecting
+inspection
+inspects
+inspiration
+inspired
+inst
+install
+installable
+installation
+installations
+installed
+installer
+installers
+installing
+installment
+installs
+instance
+instances
+instantiate
+instantiated
+instantiates
+instantiating
+instantiation
+instantly
+instead
+instr
+instruct
+instructed
+instruction
+instructions
+instructs
+instrument
+instrumented
+insufficient
+insulate
+insurance
+insure
+insured
+int
+intact
+integer
+integers
+integral
+integrate
+integrated
+integrating
+integration
+integrity
+intel
+intelligent
+intelligently
+intend
+intended
+intending
+intends
+intensity
+intensive
+intent
+intention
+intentional
+intentionally
+inter
+interact
+interacting
+interaction
+interactions
+interactive
+interactively
+interacts
+intercept
+intercepted
+intercepting
+interception
+interceptor
+intercepts
+interchange
+interchangeable
+interchangeably
+interdependencies
+interest
+interested
+interesting
+interestingly
+interests
+interface
+interfaces
+interfacing
+interfere
+interference
+interferes
+interfering
+interim
+interior
+interleave
+interleaved
+interleaving
+intermediary
+intermediate
+intermix
+intermixed
+internal
+internally
+internals
+international
+internationalization
+internationalized
+internet
+interning
+interoperability
+interoperable
+interoperate
+interpolate
+interpolated
+interpolating
+interpolation
+interpolations
+interpret
+interpretation
+interpretations
+interpreted
+interpreter
+interpreters
+interpreting
+interprets
+interrupt
+interrupted
+interruptible
+interrupting
+interruption
+interrupts
+intersection
+interspersed
+interval
+intervals
+intervening
+intimate
+into
+intra
+intrinsic
+intrinsically
+intrinsics
+intro
+introduce
+introduced
+introduces
+introducing
+introduction
+introspect
+introspectable
+introspected
+introspection
+ints
+intuitive
+invalid
+invalidate
+invalidated
+invalidates
+invalidating
+invalidation
+invariant
+invariants
+invasive
+invent
+invented
+inventory
+inverse
+inversely
+inverses
+inversion
+invert
+inverted
+inverting
+inverts
+investigate
+investigating
+investigation
+investment
+investor
+invisible
+invitation
+invite
+invited
+invocation
+invocations
+invoice
+invoiced
+invoices
+invoke
+invoked
+invokes
+invoking
+involve
+involved
+involves
+involving
+io
+ipc
+ipsum
+irc
+irrational
+irregular
+irrelevant
+irrespective
+irreversible
+is
+isatty
+isdir
+isdst
+isn
+isn't
+iso
+isolate
+isolated
+isolation
+isomorphic
+issue
+issued
+issuer
+issues
+issuing
+it
+it's
+italian
+italic
+italicized
+italics
+item
+items
+iter
+iterable
+iterables
+iterate
+iterated
+iterates
+iterating
+iteration
+iterations
+iterative
+iteratively
+iterator
+iterators
+itertools
+itinerary
+its
+itself
+ivan
+ivar
+jack
+jackson
+jail
+jain
+james
+jan
+january
+japanese
+jar
+jargon
+jason
+java
+javascript
+jean
+jeff
+jeffrey
+jenkins
+jens
+jeremy
+jesse
+jesus
+jim
+jitter
+job
+jobs
+joe
+joey
+johan
+johannes
+john
+johnson
+join
+joined
+joining
+joins
+jon
+jonas
+jonathan
+jones
+jordan
+jos
+joseph
+josh
+joshua
+journal
+journey
+jpeg
+json
+judge
+judged
+jul
+julia
+julian
+julien
+july
+jump
+jumped
+jumping
+jumps
+jun
+junctions
+june
+junk
+jure
+just
+justification
+justified
+justify
+kai
+kanji
+karen
+karl
+katakana
+kau
+kde
+keen
+keep
+keepalive
+keeping
+keeps
+keith
+kelly
+ken
+kennedy
+kenneth
+kent
+kept
+kernel
+kernels
+kevin
+key
+keybindings
+keyboard
+keychain
+keyed
+keylog
+keyring
+keys
+keyserver
+keyword
+keywords
+kick
+kicked
+kicking
+kicks
+kid
+kids
+kill
+killed
+killing
+kills
+kilobytes
+kim
+kind
+kinda
+kinds
+king
+kit
+kitchen
+kludge
+knew
+knobs
+knock
+know
+knowing
+knowledge
+known
+knows
+knuth
+korean
+koster
+kotlin
+kqueue
+kumar
+kurt
+label
+labeled
+labels
+labore
+labs
+lack
+lacking
+lacks
+laid
+lambda
+lambdas
+lame
+lance
+land
+lands
+lang
+langinfo
+language
+languages
+large
+largely
+larger
+largest
+larry
+lars
+larson
+last
+lastly
+late
+latency
+later
+latest
+latin
+latitude
+latter
+launch
+launched
+launching
+launchpad
+laurent
+law
+lax
+layer
+layers
+laying
+layout
+layouts
+lazier
+lazily
+lazy
+ldflags
+lead
+leader
+leading
+leads
+leaf
+leak
+leaked
+leaking
+leaks
+lean
+leap
+learn
+learning
+least
+leave
+leaves
+leaving
+led
+ledger
+lee
+left
+leftmost
+leftover
+legacy
+legal
+legally
+legible
+legitimate
+legitimately
+len
+length
+lengths
+lengthy
+lenient
+leon
+less
+lesser
+lesson
+lest
+let
+let's
+lets
+letter
+letters
+letting
+level
+levels
+leveraging
+lex
+lexed
+lexemes
+lexer
+lexical
+lexically
+lexicographic
+lexicographical
+lexicographically
+lexing
+liability
+liable
+lib
+libc
+libdir
+liberal
+libffi
+libfoo
+libname
+libpython
+libraries
+library
+libs
+libtool
+libwww
+licence
+license
+licensed
+licenses
+licensing
+lichtenheld
+lie
+lies
+life
+lifecycle
+lifespan
+lifetime
+lifted
+lifting
+light
+lighter
+lightly
+lightweight
+like
+likelihood
+likely
+likes
+likewise
+liking
+limbo
+limit
+limitation
+limitations
+limited
+limiting
+limits
+linaro
+line
+lineage
+linear
+linearly
+linebreak
+linefeed
+lineno
+linenumber
+lines
+linger
+linguistic
+link
+linkage
+linked
+linker
+linkers
+linking
+linkname
+links
+lint
+linter
+linters
+linux
+lisp
+list
+listed
+listen
+listener
+listeners
+listening
+listens
+listing
+listings
+lists
+literal
+literally
+literals
+literature
+little
+live
+lives
+living
+load
+loadable
+loaded
+loader
+loaders
+loading
+loads
+loan
+loc
+local
+locale
+locales
+localhost
+locality
+localization
+localize
+localized
+localizing
+locally
+localname
+locals
+localtime
+locate
+located
+locates
+locating
+location
+locations
+locator
+lock
+locked
+lockfile
+locking
+locks
+locs
+log
+logarithm
+logarithmic
+logfile
+logged
+logger
+loggers
+logging
+logic
+logical
+logically
+login
+logistics
+logo
+logopt
+logos
+logout
+logs
+lone
+long
+longer
+longest
+longitude
+look
+lookahead
+lookbehind
+looked
+looking
+looks
+lookup
+lookups
+loop
+loopback
+looped
+looping
+loops
+loose
+loosely
+lorem
+lose
+loses
+losing
+loss
+lossy
+lost
+lot
+lots
+loud
+louder
+loudly
+lovely
+low
+lower
+lowercase
+lowercased
+lowercasing
+lowering
+lowest
+loyalty
+lseek
+lstat
+lua
+lucas
+luck
+luckily
+lucky
+lukasz
+luke
+luminance
+lvalue
+lying
+lynch
+lynx
+lzma
+mac
+mach
+machine
+machinery
+machines
+macintosh
+macosx
+macro
+macros
+made
+madsen
+magenta
+magic
+magical
+magically
+magna
+magnitude
+mail
+mailbox
+mailboxes
+maildir
+mailing
+mailto
+main
+mainline
+mainly
+maint
+maintain
+maintained
+maintainer
+maintainers
+maintaining
+maintains
+maintenance
+major
+majority
+make
+makefile
+makefiles
+makes
+making
+malcolm
+malformed
+malicious
+maliciously
+malloc
+mallocs
+man
+manage
+managed
+management
+manager
+managers
+manages
+managing
+mandarin
+mandate
+mandated
+mandates
+mandatory
+mangled
+mangles
+mangling
+manifest
+manifests
+manipulate
+manipulated
+manipulates
+manipulating
+manipulation
+manipulations
+manner
+manpage
+manpages
+mantissa
+manual
+manually
+manuals
+manufacture
+manufacturer
+manufacturers
+many
+map
+mapped
+mapper
+mapping
+mappings
+maps
+mar
+marc
+march
+marcus
+marek
+margin
+marginally
+mario
+mark
+markdown
+marked
+marker
+markers
+market
+marketing
+marketplace
+marking
+markings
+marks
+markup
+marshal
+marshaled
+marshalers
+marshaling
+marshalled
+martin
+mary
+mask
+masked
+masking
+masks
+mass
+massive
+master
+match
+matched
+matcher
+matches
+matching
+material
+materialize
+materialized
+materials
+math
+mathematical
+mathematically
+matrix
+matt
+matter
+matters
+matthew
+matthias
+mattia
+mature
+max
+maximal
+maximize
+maximum
+maxlen
+maxsize
+may
+maybe
+mbox
+mday
+me
+meal
+mean
+meaning
+meaningful
+meaningfully
+meaningless
+meanings
+means
+meant
+meantime
+meanwhile
+measure
+measured
+measurement
+measures
+measuring
+mechanics
+mechanism
+mechanisms
+medeiros
+media
+median
+medical
+medium
+meet
+meeting
+meets
+megabytes
+mem
+member
+members
+membership
+memo
+memoization
+memoize
+memoized
+memoizes
+memoizing
+memories
+memory
+menon
+mention
+mentioned
+mentioning
+mentions
+menu
+merchant
+mercurial
+mere
+merely
+merge
+merged
+merges
+mergesort
+merging
+meson
+mess
+message
+messages
+messing
+messy
+met
+meta
+metacharacter
+metacharacters
+metaclass
+metadata
+metainfo
+meter
+meth
+method
+methods
+metric
+metrics
+michael
+micro
+microsecond
+microseconds
+microsoft
+mid
+middle
+middleware
+midnight
+midpoint
+midway
+might
+migrate
+migrated
+migrating
+migration
+mike
+mileage
+milestone
+milestones
+miller
+million
+millisecond
+milliseconds
+mime
+mimetype
+mimic
+mimicking
+mimics
+min
+mind
+mine
+mingw
+mini
+minimal
+minimally
+minimise
+minimize
+minimized
+minimizes
+minimum
+minimums
+minor
+minority
+minus
+minuscule
+minute
+minutes
+mips
+mirror
+mirrored
+mirroring
+mirrors
+mis
+misbehaving
+misc
+miscellaneous
+misconfigured
+mishandled
+misidentified
+misinterpreted
+misleading
+mismatch
+mismatched
+mismatches
+mismatching
+misnamed
+misnomer
+misparsed
+misplaced
+misrepresented
+miss
+misses
+missing
+misspelled
+misspellings
+mistake
+mistaken
+mistakenly
+mistakes
+mistaking
+mistype
+mistyped
+misuse
+misused
+mitchell
+mitigate
+mix
+mixed
+mixin
+mixing
+mixture
+mkdir
+mkdtemp
+mktemp
+mmap
+mnemonic
+mnemonics
+mobile
+mock
+mocked
+mocking
+mocks
+mod
+modal
+mode
+model
+modeled
+modeling
+modelled
+models
+moderate
+moderately
+modern
+modes
+modifiable
+modification
+modifications
+modified
+modifier
+modifiers
+modifies
+modify
+modifying
+mods
+modtime
+modular
+module
+modulename
+modules
+modulo
+modulus
+mojave
+moment
+moments
+mon
+monday
+monitor
+monitoring
+monkey
+monkeypatched
+monkeypatching
+mono
+monospace
+monotonically
+month
+monthly
+months
+moral
+more
+moreover
+morgan
+mortgage
+most
+mostly
+motivated
+motivation
+mount
+mounted
+mounts
+move
+moved
+movement
+moves
+movie
+moving
+mozilla
+mro
+msec
+msecs
+msgid
+mtime
+mtimes
+much
+muck
+mueller
+mul
+mullender
+multi
+multiarch
+multibyte
+multicast
+multicasting
+multidimensional
+multilib
+multiline
+multipart
+multipath
+multiple
+multiples
+multiplexer
+multiplexing
+multiplication
+multiplicative
+multiplied
+multiplier
+multipliers
+multiplies
+multiply
+multiplying
+multithreaded
+multithreading
+munge
+munged
+munging
+musl
+must
+mustn
+mutable
+mutate
+mutated
+mutates
+mutating
+mutation
+mutations
+mutator
+mutators
+mutex
+mutexes
+mutual
+mutually
+my
+myers
+mypkg
+myscript
+myself
+mysterious
+nagle
+naive
+name
+named
+namelen
+nameless
+namely
+names
+namespace
+namespaces
+naming
+namlen
+nan
+nanosecond
+nanoseconds
+nargs
+narrow
+narrowed
+narrower
+narrowing
+nasm
+nasty
+nat
+nathan
+national
+native
+natively
+natural
+naturally
+nature
+navigate
+navigation
+nbits
+nbytes
+ndigits
+near
+nearby
+nearest
+nearly
+neat
+neatly
+necessarily
+necessary
+necessity
+need
+needed
+needing
+needless
+needlessly
+needn
+needs
+neg
+negate
+negated
+negates
+negating
+negation
+negations
+negative
+negatively
+negatives
+negligible
+negotiate
+negotiated
+negotiation
+neighboring
+neighbours
+neil
+neither
+nest
+nested
+nesting
+net
+netbsd
+netdb
+netmask
+netrc
+netscape
+network
+networking
+networks
+neutral
+never
+nevertheless
+new
+newer
+newest
+newlen
+newline
+newlines
+newly
+newman
+newname
+news
+newton
+next
+nginx
+nice
+nicely
+nicer
+nicholas
+nick
+nickname
+nicolas
+nid
+niels
+nielsen
+night
+nightmare
+nik
+nikita
+nikola
+nil
+nils
+nine
+nit
+nits
+nix
+nlink
+nmake
+no
+noatime
+nobody
+node
+nodename
+nodes
+noise
+noisy
+nominal
+nominated
+non
+nonblocking
+nonce
+nonces
+nondeterministic
+none
+nonempty
+nonetheless
+nonexistent
+nonnegative
+nonsensical
+nonstandard
+nonzero
+noop
+nor
+norm
+normal
+normalise
+normalised
+normalization
+normalize
+normalized
+normalizes
+normalizing
+normally
+north
+not
+notable
+notably
+notation
+notations
+note
+noted
+notes
+nothing
+notice
+noticeable
+noticeably
+noticed
+notices
+noticing
+notification
+notifications
+notified
+notifies
+notify
+notifying
+noting
+notion
+nouns
+nov
+november
+now
+nowadays
+nowhere
+nowrap
+nroff
+nsec
+null
+nullable
+nulled
+nulls
+num
+number
+numbered
+numbering
+numbers
+numeral
+numerals
+numerator
+numeric
+numerical
+numerically
+numerics
+numerous
+numpy
+nums
+nutshell
+oauth
+obey
+obeying
+obeys
+obfuscation
+obj
+objc
+objdump
+object
+objective
+objects
+objs
+obligated
+oblique
+obs
+obscure
+obscured
+observable
+observation
+observe
+observed
+obsolete
+obsoleted
+obsoletes
+obtain
+obtained
+obtaining
+obtains
+obvious
+obviously
+occasional
+occasionally
+occasions
+occupancy
+occupied
+occupies
+occupy
+occupying
+occur
+occurred
+occurrence
+occurrences
+occurring
+occurs
+oct
+octal
+octals
+octet
+octets
+october
+odd
+oddball
+oddities
+oddly
+odds
+of
+off
+offending
+offer
+offered
+offering
+offers
+office
+official
+officially
+offline
+offload
+offset
+offsets
+often
+oh
+oid
+ois
+ok
+okamoto
+okay
+old
+older
+oldest
+oldlen
+oldname
+olivier
+omission
+omit
+omits
+omitted
+omitting
+on
+onboarding
+once
+onclick
+one
+oneliner
+oneof
+onerror
+ones
+ongoing
+online
+only
+onto
+onward
+onwards
+oob
+oops
+opaque
+opcode
+opcodes
+open
+openbsd
+opendir
+opened
+opening
+openings
+opens
+openssl
+opera
+operand
+operands
+operate
+operated
+operates
+operating
+operation
+operational
+operations
+operator
+operators
+opinion
+opinions
+opmask
+opname
+opportunities
+opportunity
+opposed
+opposite
+ops
+opt
+opted
+optimal
+optimisation
+optimisations
+optimised
+optimistic
+optimistically
+optimization
+optimizations
+optimize
+optimized
+optimizer
+optimizes
+optimizing
+opting
+option
+optional
+optionally
+options
+opts
+or
+oracle
+orange
+ord
+order
+ordered
+ordering
+orders
+ordinal
+ordinals
+ordinarily
+ordinary
+org
+organisation
+organization
+organizations
+organize
+organized
+organizing
+orientation
+oriented
+orig
+origin
+original
+originally
+originals
+originate
+originated
+originates
+originating
+origins
+orphan
+os
+osname
+other
+others
+otherwise
+ought
+our
+ourselves
+out
+outbound
+outbuf
+outcome
+outcomes
+outdated
+outdir
+outer
+outermost
+outfd
+outfile
+outgoing
+outline
+outlined
+outlive
+output
+outputs
+outputted
+outputting
+outright
+outside
+outsider
+outstanding
+outweighs
+over
+overall
+overcome
+overdraft
+overflow
+overflowing
+overflows
+overhead
+overheads
+overkill
+overlap
+overlapped
+overlapping
+overlaps
+overlay
+overlays
+overlined
+overload
+overloaded
+overloading
+overloads
+overly
+overridable
+overridden
+override
+overrides
+overriding
+overruled
+overrun
+overrunning
+overruns
+oversight
+oversized
+overuse
+overview
+overwhelm
+overwrite
+overwrites
+overwriting
+overwritten
+owe
+owing
+own
+owned
+owner
+owners
+ownership
+owning
+owns
+pack
+package
+packaged
+packager
+packagers
+packages
+packaging
+packed
+packet
+packets
+packfile
+packing
+packs
+pad
+padded
+padding
+pads
+page
+pager
+pagers
+pages
+paginate
+paginated
+paginating
+pagination
+paging
+paid
+pain
+paint
+pair
+paired
+pairing
+pairs
+pairwise
+palette
+pan
+panel
+panic
+paolo
+paper
+papers
+para
+paradigm
+paradise
+paragraph
+paragraphs
+parallel
+parallels
+param
+parameter
+parameterize
+parameterized
+parameters
+parametric
+params
+paranoia
+paranoid
+parcel
+paren
+parens
+parent
+parentheses
+parenthesis
+parenthesized
+parents
+parity
+parsable
+parse
+parseable
+parsed
+parser
+parsers
+parses
+parsing
+part
+partial
+partially
+participant
+participate
+participation
+particular
+particularly
+parties
+partition
+partitioned
+partitions
+partly
+partner
+parts
+party
+pascal
+pass
+passed
+passenger
+passes
+passing
+passive
+passphrase
+passport
+passthrough
+passwd
+password
+passwords
+past
+paste
+pasted
+pasting
+pat
+patch
+patched
+patches
+patching
+patchlevel
+path
+pathlib
+pathname
+pathnames
+pathological
+paths
+pathsep
+pathspec
+patience
+patient
+patrick
+pattern
+patterns
+paul
+pause
+paused
+pax
+pay
+payable
+payee
+payer
+paying
+payload
+payloads
+payment
+payments
+payout
+payroll
+pays
+peak
+peculiar
+pedantic
+peek
+peeks
+peel
+peer
+peeraddr
+peername
+peers
+pen
+penalty
+pending
+people
+pep
+per
+percent
+percentage
+percentile
+percentiles
+perfect
+perfectly
+perforce
+perform
+performance
+performances
+performant
+performed
+performing
+performs
+perhaps
+peril
+period
+periodic
+periodically
+periods
+perl
+perldoc
+permanent
+permanently
+permissible
+permission
+permissions
+permissive
+permit
+permits
+permitted
+permitting
+perms
+permutation
+permutations
+permute
+perror
+perry
+persist
+persistence
+persistent
+persists
+person
+personal
+personalization
+personally
+persons
+perspective
+pertain
+pertaining
+pervasive
+peter
+peters
+peterson
+petr
+phase
+phases
+phi
+phil
+philippe
+phillip
+philosophy
+phis
+phone
+photo
+phrase
+phrases
+physical
+physically
+pi
+pick
+picked
+picking
+pickled
+picks
+pickup
+picky
+picture
+pid
+pie
+piece
+pieces
+pin
+ping
+pings
+pink
+pinned
+pinning
+pinpointing
+pins
+piotr
+pip
+pipe
+piped
+pipeline
+pipelines
+pipes
+piping
+pitfall
+pivot
+pixels
+pkey
+pkgname
+pkgpath
+place
+placed
+placeholder
+placeholders
+placement
+places
+placing
+plain
+plainly
+plaintext
+plan
+plane
+planned
+planning
+plans
+platform
+platforms
+plausible
+play
+playback
+player
+players
+playing
+playlist
+plays
+please
+plenty
+plist
+plug
+pluggable
+plugged
+plugging
+plugin
+plugins
+plumbing
+plural
+plus
+pobox
+pod
+pods
+point
+pointed
+pointer
+pointers
+pointing
+pointless
+points
+poison
+poking
+polar
+pole
+policies
+policy
+polish
+polite
+poll
+polled
+polling
+polls
+pollute
+polluting
+pollution
+poly
+polygon
+polymorphism
+polynomial
+pool
+pooled
+pooling
+pools
+poor
+poorly
+pop
+popen
+popped
+popping
+pops
+popular
+popularity
+populate
+populated
+populates
+populating
+population
+popup
+porcelain
+port
+portability
+portable
+portably
+portal
+ported
+portfolio
+porting
+portion
+portions
+ports
+portuguese
+pos
+position
+positional
+positionals
+positioned
+positioning
+positions
+positive
+positives
+posix
+posn
+possessive
+possibilities
+possibility
+possible
+possibly
+post
+postal
+postcode
+posted
+postfix
+postgres
+posting
+postorder
+postpone
+postponed
+postprocessing
+pot
+potential
+potentially
+pow
+power
+powerful
+powers
+practical
+practically
+practice
+practices
+pragma
+pragmas
+praise
+pre
+preamble
+prebuild
+prebuilt
+prec
+precede
+preceded
+precedence
+precedences
+precedes
+preceding
+precise
+precisely
+precision
+precisions
+preclude
+precludes
+precompiled
+precompute
+precondition
+preconditions
+pred
+predates
+predecessor
+predecessors
+predeclare
+predeclared
+predefine
+predefined
+predicate
+predicates
+predict
+predictable
+predicting
+prediction
+preexisting
+pref
+prefer
+preferable
+preferably
+preference
+preferences
+preferred
+preferring
+prefers
+prefetch
+prefix
+prefixed
+prefixes
+prefixing
+prefixlen
+preformatted
+preliminary
+preload
+preloaded
+preloading
+premature
+prematurely
+premium
+preorder
+prep
+preparation
+preparatory
+prepare
+prepared
+prepares
+preparing
+prepend
+prepended
+prepending
+prepends
+preposition
+preprocess
+preprocessed
+preprocessing
+preprocessor
+prerelease
+prereleases
+prereq
+prereqs
+prerequisite
+prerequisites
+prescription
+presence
+present
+presentation
+presented
+presenting
+presently
+presents
+preservation
+preserve
+preserved
+preserves
+preserving
+preset
+press
+pressed
+presses
+pressing
+pressure
+presumably
+presume
+presumed
+pretend
+pretending
+pretends
+prettier
+prettify
+pretty
+prev
+prevent
+prevented
+preventing
+prevents
+preview
+previous
+previously
+price
+pricing
+primality
+primarily
+primary
+prime
+primed
+primes
+primitive
+primitives
+principal
+principle
+print
+printable
+printed
+printer
+printers
+printf
+printing
+prints
+prior
+priorities
+prioritize
+prioritized
+priority
+privacy
+private
+privilege
+privileged
+privileges
+pro
+proactively
+prob
+probabilistic
+probability
+probable
+probably
+probe
+probed
+probing
+problem
+problematic
+problems
+proc
+procedural
+procedure
+procedures
+proceed
+proceeding
+proceeds
+process
+processed
+processes
+processing
+processor
+processors
+procs
+produce
+produced
+producer
+producers
+produces
+producing
+product
+production
+productions
+products
+prof
+profile
+profiled
+profiler
+profilers
+profiles
+profiling
+profit
+prog
+program
+programmatic
+programmatically
+programmed
+programmer
+programmers
+programming
+programs
+progress
+progresses
+progressive
+progressively
+prohibit
+prohibited
+prohibitively
+prohibits
+project
+projects
+prolog
+prologue
+promise
+promised
+promises
+promo
+promote
+promoted
+promotes
+promoting
+promotion
+prompt
+prompted
+prompting
+promptly
+prompts
+prone
+proof
+prop
+propagate
+propagated
+propagates
+propagating
+propagation
+proper
+properly
+properties
+property
+proportional
+proposal
+proposals
+propose
+proposed
+proposes
+proprietary
+pros
+prose
+prot
+protect
+protected
+protecting
+protection
+protections
+protective
+protects
+proto
+protobuf
+protocol
+protocols
+protoent
+prototype
+prototypes
+prove
+proved
+proven
+provide
+provided
+provider
+providers
+provides
+providing
+province
+proving
+provision
+provisional
+provoke
+provokes
+proxied
+proxies
+proxy
+proxying
+prudent
+prune
+pruned
+pruning
+pseudo
+pseudocode
+pseudorandom
+pthread
+pthreads
+pty
+pub
+public
+publication
+publicity
+publicly
+publish
+published
+publisher
+publishes
+publishing
+pull
+pulled
+pulling
+pulls
+pump
+punct
+punctuation
+punt
+punting
+punycode
+purchase
+pure
+purely
+purge
+purpose
+purposefully
+purposes
+push
+pushback
+pushed
+pushes
+pushing
+put
+puts
+putting
+pypa
+pypi
+python
+pythonic
+qtext
+quad
+quadratic
+quadruple
+qualification
+qualified
+qualifier
+qualifiers
+qualify
+quality
+quant
+quantification
+quantifiers
+quantify
+quantities
+quantity
+quantize
+quantum
+quarter
+quasi
+queried
+queries
+query
+querying
+querystring
+question
+questionable
+questions
+queue
+queued
+queueing
+queues
+queuing
+quick
+quicker
+quickly
+quiet
+quietly
+quinlan
+quirk
+quirks
+quit
+quite
+quits
+quitting
+quot
+quota
+quotation
+quote
+quoted
+quotes
+quotient
+quoting
+quux
+rabin
+race
+raced
+races
+racing
+racy
+radians
+radius
+radix
+rafael
+rainer
+raise
+raised
+raises
+raising
+ram
+ran
+rand
+randal
+random
+randomization
+randomize
+randomized
+randomly
+randomness
+randy
+range
+ranges
+ranging
+rank
+ranlib
+raphael
+rapid
+rapidly
+rare
+rarely
+raster
+rat
+rate
+rates
+rather
+rating
+ratio
+ration
+rational
+rationale
+rationals
+ratios
+raw
+ray
+raymond
+rdev
+re
+reach
+reachability
+reachable
+reached
+reaches
+reaching
+reacquire
+react
+read
+readability
+readable
+readdir
+reader
+readers
+readily
+readiness
+reading
+readline
+readlink
+readme
+readonly
+reads
+ready
+real
+realistic
+reality
+realize
+realized
+realizes
+realloc
+reallocated
+reallocation
+really
+realm
+realms
+realpath
+realtime
+reap
+rearrange
+rearranged
+rearranging
+reason
+reasonable
+reasonably
+reasoning
+reasons
+reassign
+reassigned
+reassignment
+rebase
+rebind
+rebinding
+reboot
+rebuild
+rebuilding
+rebuilt
+rec
+recalculate
+recalculated
+recall
+receipt
+receivable
+receive
+received
+receiver
+receivers
+receives
+receiving
+recent
+recently
+recheck
+recipe
+recipes
+recipient
+recipients
+reciprocal
+reclaim
+reclaimed
+recognise
+recognised
+recognition
+recognizable
+recognize
+recognized
+recognizes
+recognizing
+recommend
+recommendation
+recommendations
+recommended
+recommends
+recompile
+recompiled
+recompiling
+recompute
+recomputed
+reconfigure
+reconnect
+reconnecting
+reconsider
+reconstruct
+reconstructed
+reconstructing
+reconstructs
+record
+recorded
+recording
+records
+recover
+recoverable
+recovered
+recovers
+recovery
+recreate
+recreated
+rect
+rectangle
+rectangular
+recurrence
+recurring
+recurse
+recursed
+recurses
+recursing
+recursion
+recursions
+recursive
+recursively
+recv
+recvfrom
+recycled
+recycling
+red
+redact
+redacted
+redeclared
+redeem
+redefine
+redefined
+redefines
+redefinition
+redemption
+redesign
+redesigned
+redhat
+redirect
+redirected
+redirecting
+redirection
+redirections
+redirects
+redistribute
+redistributed
+redistribution
+redistributions
+redo
+reduce
+reduced
+reduces
+reducing
+reduction
+redundant
+redundantly
+reed
+reentrant
+reevaluation
+ref
+refactor
+refactored
+refactoring
+refcount
+refer
+reference
+referenced
+references
+referencing
+referent
+referral
+referred
+referring
+refers
+refill
+refinement
+reflect
+reflected
+reflecting
+reflection
+reflects
+reflog
+refname
+reformat
+reformats
+refresh
+refreshed
+refreshes
+refs
+refund
+refunded
+refuse
+refused
+refuses
+refusing
+reg
+regard
+regarded
+regarding
+regardless
+regards
+regen
+regenerate
+regenerated
+regenerates
+regex
+regexes
+regexp
+regexps
+region
+regions
+register
+registered
+registering
+registers
+registration
+registries
+registry
+regression
+regular
+regularly
+rehash
+reimplement
+reimplementation
+reimplemented
+reindent
+reinitialize
+reinitialized
+reinvent
+reject
+rejected
+rejecting
+rejection
+rejects
+rel
+relate
+related
+relates
+relating
+relation
+relational
+relations
+relationship
+relationships
+relative
+relatively
+relax
+relaxed
+relay
+release
+released
+releaser
+releases
+releasing
+relevance
+relevant
+reliability
+reliable
+reliably
+relied
+relies
+relink
+relinquishes
+reload
+reloaded
+reloading
+reloads
+relocatable
+relocate
+relocated
+relocation
+relocations
+rely
+relying
+remain
+remainder
+remaining
+remains
+remap
+remapped
+remapping
+remark
+remarks
+remedy
+remember
+remembered
+remembering
+remembers
+remind
+reminder
+remote
+remotely
+removable
+removal
+removals
+remove
+removed
+removes
+removing
+ren
+rename
+renamed
+renames
+renaming
+render
+rendered
+renderer
+renderers
+rendering
+renders
+renew
+renewal
+rent
+rental
+reopen
+reopened
+reorder
+reordered
+reordering
+repack
+repair
+repaired
+reparse
+repeat
+repeatability
+repeatable
+repeated
+repeatedly
+repeating
+repeats
+repertoire
+repetition
+repetitions
+repetitive
+repl
+replace
+replaceable
+replaced
+replacement
+replacements
+replaces
+replacing
+replay
+replica
+replicate
+replication
+replied
+replies
+reply
+repo
+repopulate
+report
+reported
+reportedly
+reporter
+reporting
+reports
+repos
+reposition
+repositories
+repository
+repr
+represent
+representable
+representation
+representations
+representative
+represented
+representing
+represents
+reproduce
+reproduced
+reproduces
+reproducibility
+reproducible
+reproducing
+req
+reqs
+request
+requested
+requester
+requesting
+requests
+require
+required
+requirement
+requirements
+requires
+requiring
+requisite
+rerun
+rerunning
+res
+rescan
+reschedule
+research
+reseed
+resemble
+resembles
+resembling
+resend
+reservation
+reserve
+reserved
+reserving
+reset
+resets
+resetting
+reside
+resident
+resides
+residue
+resilient
+resistance
+resistant
+resize
+resized
+resolution
+resolutions
+resolve
+resolved
+resolver
+resolves
+resolving
+resort
+resorting
+resource
+resources
+resp
+respect
+respected
+respecting
+respective
+respectively
+respects
+respond
+responded
+responder
+responding
+responds
+response
+responses
+responsibility
+responsible
+rest
+restart
+restarted
+restarting
+restarts
+restaurant
+restore
+restored
+restores
+restoring
+restrict
+restricted
+restricting
+restriction
+restrictions
+restrictive
+restricts
+restructure
+restructured
+result
+resultant
+resulted
+resulting
+results
+resume
+resumed
+resumes
+resuming
+resumptions
+resurrect
+resurrected
+ret
+retail
+retailer
+retain
+retained
+retaining
+retains
+retention
+rethrow
+retire
+retired
+retried
+retries
+retrievable
+retrieval
+retrieve
+retrieved
+retrieves
+retrieving
+retry
+retrying
+return
+returned
+returning
+returns
+reusable
+reuse
+reused
+reuses
+reusing
+rev
+revamped
+reveal
+revealed
+revealing
+reveals
+revenue
+reverse
+reversed
+reverses
+reversible
+reversing
+reversion
+revert
+reverted
+reverts
+review
+reviewed
+reviewer
+reviewing
+reviews
+revised
+revision
+revisions
+revisit
+revisited
+revocation
+revoke
+revoked
+reward
+rewind
+rewinding
+rewinds
+reword
+rework
+reworked
+rewrite
+rewrites
+rewriting
+rewritten
+rewrote
+reynolds
+ricardo
+rich
+richard
+richer
+rick
+rid
+rider
+ridiculous
+right
+rightmost
+rights
+rigorous
+ring
+rip
+ripped
+risk
+risking
+risks
+risky
+rmdir
+rmtree
+road
+rob
+robert
+robin
+robinson
+robust
+robustness
+roderick
+roff
+roland
+role
+roles
+roll
+rollback
+rolled
+rolling
+roman
+ron
+ronald
+room
+root
+rootdir
+rooted
+roots
+ross
+rossum
+rotate
+rotated
+rotates
+rotating
+rotation
+rough
+roughly
+round
+rounded
+rounding
+rounds
+roundtrip
+route
+routed
+routes
+routine
+routinely
+routines
+routing
+row
+rows
+roy
+rpath
+rpc
+rpcs
+rsa
+rsync
+rtype
+ruby
+rudimentary
+rule
+ruler
+rules
+run
+runnable
+runner
+runners
+running
+runs
+runtime
+runtimes
+rusage
+russ
+russian
+rust
+rvalue
+sacrifice
+sad
+sadly
+safe
+safeguard
+safely
+safer
+safest
+safety
+said
+sake
+salary
+sale
+sales
+salt
+salted
+sam
+same
+sample
+sampled
+samples
+sampling
+sandbox
+sandboxed
+sandboxing
+sanders
+sane
+saner
+sanitize
+sanitized
+sanitizer
+sanity
+sans
+sat
+satisfied
+satisfies
+satisfy
+satisfying
+saturated
+saturation
+saturday
+save
+saved
+saves
+saving
+savings
+saw
+saxon
+say
+saying
+says
+scaffolding
+scalar
+scalars
+scale
+scaled
+scales
+scan
+scanned
+scanner
+scanning
+scans
+scary
+scatters
+scenario
+scenarios
+scenes
+schedule
+scheduled
+scheduler
+schedulers
+schedules
+scheduling
+schema
+schemas
+scheme
+schemes
+schmidt
+schwartz
+science
+scientific
+scope
+scoped
+scopes
+scoping
+score
+scores
+scott
+scratch
+scream
+screams
+screen
+screw
+screwed
+script
+scripting
+scripts
+scroll
+scrolling
+scrypt
+sdist
+seals
+sean
+search
+searchable
+searched
+searches
+searching
+season
+seat
+sebastian
+sebastien
+sec
+second
+secondary
+seconds
+secrecy
+secret
+secrets
+section
+sections
+sector
+secure
+securely
+security
+sed
+see
+seealso
+seed
+seeded
+seeding
+seeds
+seeing
+seek
+seekable
+seeked
+seeking
+seeks
+seem
+seemed
+seemingly
+seems
+seen
+sees
+segfault
+segfaults
+segment
+segmentation
+segments
+select
+selectable
+selected
+selecting
+selection
+selections
+selective
+selectively
+selector
+selectors
+selects
+self
+sell
+seller
+sem
+semantic
+semantically
+semantics
+semaphore
+semaphores
+semi
+semicolon
+semicolons
+semis
+send
+sender
+sendfile
+sending
+sendmail
+sends
+sendto
+sense
+sensible
+sensibly
+sensitive
+sensitivity
+sensor
+sent
+sentence
+sentences
+sentinel
+sep
+separate
+separated
+separately
+separates
+separating
+separation
+separator
+separators
+september
+seq
+sequence
+sequencer
+sequences
+sequencing
+sequential
+sequentially
+sergeant
+serial
+serializable
+serialization
+serialize
+serialized
+serializer
+serializes
+serializing
+serially
+series
+serif
+serious
+seriously
+serve
+served
+servent
+server
+servers
+serves
+service
+services
+serving
+sesame
+session
+sessions
+set
+setgid
+seth
+setlocale
+sets
+setsockopt
+settable
+setter
+setters
+setting
+settings
+settle
+settled
+settlement
+setuid
+setup
+setuptools
+seven
+several
+severe
+severity
+sha
+shades
+shadow
+shadowed
+shadowing
+shadows
+shall
+shallow
+shame
+shape
+shaped
+shapes
+share
+shareable
+shared
+shares
+sharing
+sharp
+shay
+she
+shebang
+sheet
+shell
+shells
+sherman
+shift
+shifted
+shifting
+shifts
+shiftwidth
+shim
+ship
+shipment
+shipped
+shipper
+shipping
+ships
+shlibs
+shop
+shopping
+short
+shortcomings
+shortcut
+shortcuts
+shorten
+shortened
+shorter
+shortest
+shorthand
+shorthands
+shortly
+shot
+should
+shouldn
+shouldn't
+show
+showed
+showing
+shown
+shows
+shrink
+shrinking
+shrunk
+shuffle
+shuffled
+shut
+shutdown
+shuts
+shutting
+shy
+sibling
+side
+sides
+sideways
+sig
+sigaction
+sigh
+sighandler
+sigil
+sigma
+sign
+signal
+signaled
+signaling
+signalled
+signalling
+signals
+signature
+signatures
+signed
+signer
+significance
+significand
+significant
+significantly
+signifies
+signify
+signifying
+signing
+signs
+signum
+signup
+sigset
+silence
+silenced
+silencing
+silent
+silently
+silly
+silva
+similar
+similarity
+similarly
+simon
+simple
+simplejson
+simpler
+simplest
+simplicity
+simplification
+simplifications
+simplified
+simplifies
+simplify
+simplifying
+simplistic
+simply
+sims
+simulate
+simulated
+simulates
+simulating
+simulation
+simultaneous
+simultaneously
+sin
+since
+sine
+single
+singleton
+singletons
+singly
+singular
+sinh
+sink
+sint
+sit
+site
+sites
+sits
+sitting
+situation
+situations
+six
+sixth
+siz
+size
+sized
+sizeof
+sizes
+skeletal
+skeleton
+skew
+skewing
+ski
+skill
+skip
+skipped
+skipping
+skips
+sku
+slack
+slash
+slashes
+slate
+sleep
+sleeping
+sleeps
+slept
+slice
+sliced
+slices
+slicing
+slide
+sliding
+slight
+slightly
+slim
+slip
+sloppy
+slot
+slots
+slow
+slowdown
+slowed
+slower
+slowest
+slowing
+slowly
+slows
+slurp
+slurped
+small
+smaller
+smallest
+smalltalk
+smart
+smarter
+smarts
+smith
+smoke
+smuggle
+smuggling
+snap
+snapshot
+snapshots
+sneak
+sneaky
+snippet
+snippets
+snprintf
+snuck
+so
+sock
+sockaddr
+socket
+socketpair
+sockets
+socks
+socktype
+soft
+software
+solaris
+sole
+solely
+solid
+solution
+solutions
+solve
+solved
+solves
+some
+somebody
+someday
+somefile
+somehow
+someone
+something
+sometime
+sometimes
+somewhat
+somewhere
+soon
+sooner
+sophisticated
+sorry
+sort
+sorted
+sorting
+sorts
+sought
+sound
+sounds
+source
+sourced
+sources
+space
+spaces
+spacing
+spam
+span
+spanish
+spans
+sparingly
+spawn
+spawned
+spawning
+spawns
+speak
+speaking
+spec
+special
+specializations
+specialized
+specially
+specials
+species
+specific
+specifically
+specification
+specifications
+specifics
+specified
+specifier
+specifiers
+specifies
+specify
+specifying
+specs
+sped
+speed
+speeding
+speeds
+speedup
+speedups
+spell
+spelled
+spelling
+spellings
+spells
+spend
+spent
+sphinx
+spin
+spinner
+spirit
+spit
+splice
+split
+splits
+splitting
+spoken
+sponsor
+spool
+spot
+spots
+spread
+spreadsheet
+spreadsheets
+spring
+sprintf
+spurious
+spy
+sqlite
+square
+squares
+squash
+squashed
+squashing
+squeezing
+stab
+stability
+stabilize
+stabilizes
+stable
+stack
+stacked
+stacking
+stacks
+stacktrace
+stage
+stages
+stale
+stamp
+stamps
+stand
+standalone
+standard
+standardization
+standardize
+standardized
+standards
+standing
+stands
+stanza
+stanzas
+star
+start
+started
+starter
+starting
+starts
+startup
+starvation
+starving
+stash
+stashed
+stashes
+stat
+state
+stated
+stateful
+stateless
+statement
+statements
+states
+static
+statically
+stating
+station
+statistic
+statistical
+statistics
+stats
+status
+statuses
+stay
+staying
+stays
+stdcall
+stddef
+stderr
+stdin
+stdio
+stdlib
+stdout
+steady
+steal
+stealing
+steen
+stefan
+steffen
+stem
+stems
+step
+stephen
+stepping
+steps
+stepwise
+steve
+steven
+stick
+sticking
+sticky
+still
+stock
+stolen
+stomp
+stomped
+stop
+stopped
+stopping
+stops
+storable
+storage
+store
+stored
+stores
+storing
+story
+straight
+straightforward
+strange
+strangely
+strategies
+strategy
+stray
+stream
+streamed
+streaming
+streamlined
+streamlining
+streams
+street
+strength
+strengthen
+strerror
+stretch
+strftime
+strict
+stricter
+strictly
+strictness
+strike
+strikethrough
+string
+stringent
+stringification
+stringified
+stringifies
+stringify
+stringifying
+strings
+strip
+stripped
+stripping
+strips
+strives
+stroke
+strong
+stronger
+strongest
+strongly
+strptime
+struct
+structs
+structural
+structure
+structured
+structures
+stub
+stubbed
+stubs
+stuck
+student
+studio
+study
+stuff
+stuffed
+stupid
+style
+styled
+styleguide
+styles
+stylesheet
+stylesheets
+styling
+stylistic
+sub
+subclass
+subclassed
+subclasses
+subclassing
+subcommand
+subcommands
+subdir
+subdirectories
+subdirectory
+subdirs
+subdivided
+subexpressions
+subfolder
+subgraph
+subject
+subjectively
+subkey
+subkeys
+sublicense
+submatches
+submission
+submit
+submits
+submitted
+submitting
+submodule
+submodules
+subname
+subnet
+subnets
+subnormal
+subobjects
+suboptimal
+subpackage
+subpackages
+subpart
+subpath
+subpattern
+subpatterns
+subprocess
+subprocesses
+subprogram
+subroutine
+subroutines
+subs
+subscribe
+subscribed
+subscriber
+subscribers
+subscript
+subscription
+subscriptions
+subscripts
+subsecond
+subsection
+subsequence
+subsequences
+subsequent
+subsequently
+subset
+subsets
+subshell
+subsidiary
+subslices
+subst
+substantial
+substantially
+substitute
+substituted
+substitutes
+substituting
+substitution
+substitutions
+substr
+substring
+substrings
+subsumed
+subsystem
+subsystems
+subtest
+subtests
+subtitle
+subtle
+subtlety
+subtly
+subtotal
+subtract
+subtracted
+subtracting
+subtraction
+subtractions
+subtracts
+subtree
+subtrees
+subtype
+subtypes
+subversion
+succeed
+succeeded
+succeeding
+succeeds
+success
+successful
+successfully
+successive
+successively
+successor
+successors
+succinctly
+such
+suck
+suddenly
+sudo
+suffer
+suffered
+suffice
+suffices
+sufficient
+sufficiently
+suffix
+suffixed
+suffixes
+sugar
+suggest
+suggested
+suggesting
+suggestion
+suggestions
+suggests
+suicide
+suit
+suitability
+suitable
+suitably
+suite
+suited
+suites
+suits
+sum
+summaries
+summarize
+summarized
+summarizes
+summarizing
+summary
+summing
+sums
+sun
+sunday
+super
+superclass
+superclasses
+superfluous
+superscript
+superseded
+supersedes
+superset
+supplement
+supplemental
+supplementary
+supplied
+supplier
+supplies
+supply
+supplying
+support
+supported
+supporting
+supports
+suppose
+supposed
+supposedly
+suppress
+suppressed
+suppresses
+suppressing
+suppression
+surcharge
+sure
+surface
+surfaces
+surprise
+surprised
+surprises
+surprising
+surprisingly
+surrogate
+surrogates
+surround
+surrounded
+surrounding
+survey
+survive
+susceptible
+suspect
+suspected
+suspend
+suspended
+suspending
+suspends
+suspension
+suspicious
+swallow
+swallowing
+swallows
+swap
+swapped
+swapping
+swaps
+swedish
+sweet
+sweeter
+swift
+swig
+swiss
+switch
+switched
+switches
+switching
+sym
+symbol
+symbolic
+symbolically
+symbols
+symlink
+symlinked
+symlinks
+symmetric
+symmetrical
+symmetry
+symref
+syms
+sync
+synch
+synchronization
+synchronize
+synchronized
+synchronizes
+synchronizing
+synchronous
+synchronously
+syncing
+synonym
+synonymous
+synonyms
+synopsis
+syntactic
+syntactically
+syntax
+syntaxes
+synthesize
+synthetic
+sys
+sysadmin
+syscall
+syscalls
+sysctl
+syslog
+system
+systematically
+systemd
+systems
+tab
+table
+tables
+tabs
+tabstop
+tack
+tad
+tag
+tagged
+tagging
+tagname
+tags
+tail
+tailor
+tailored
+tails
+taint
+tainted
+taiwan
+take
+taken
+takes
+taking
+talk
+talking
+tamil
+tampered
+tan
+tangent
+tap
+tape
+tar
+tarball
+tarballs
+tarfile
+targ
+target
+targeted
+targeting
+targets
+task
+tasks
+taught
+tax
+taxes
+taxonomy
+taylor
+tchar
+tea
+team
+teardown
+technical
+technically
+technique
+techniques
+technology
+tedious
+tee
+tell
+telling
+tells
+telnet
+temp
+tempdir
+temperature
+tempfile
+template
+templates
+templating
+temple
+temporaries
+temporarily
+temporary
+tempted
+tempting
+ten
+tenant
+tend
+tendency
+tends
+tens
+tense
+tentatively
+tenth
+term
+termcap
+terminal
+terminals
+terminate
+terminated
+terminates
+terminating
+termination
+terminations
+terminator
+terminators
+terminology
+termios
+terms
+ternary
+terrible
+terribly
+territory
+terse
+test
+testable
+testcase
+testcases
+testdir
+tested
+tester
+testers
+testfile
+testing
+tests
+testsuite
+text
+texts
+textual
+textually
+texture
+thai
+than
+thank
+thanks
+that
+that's
+the
+their
+theirs
+them
+theme
+themselves
+then
+theorem
+theoretical
+theoretically
+theory
+there
+there's
+thereafter
+thereby
+therefore
+therein
+thereof
+thereto
+these
+they
+thin
+thing
+things
+think
+thinking
+thinks
+third
+this
+thomas
+thorough
+those
+though
+thought
+thoughts
+thousand
+thousands
+thrashing
+thread
+threaded
+threading
+threads
+threadsafe
+threat
+three
+threshold
+threw
+through
+throughout
+throughput
+throw
+throwing
+thrown
+throws
+thu
+thumb
+thumbnail
+thunk
+thursday
+thus
+tick
+ticket
+tickets
+ticks
+tid
+tidy
+tie
+tied
+tier
+ties
+tight
+tighter
+tightly
+tilde
+till
+tim
+time
+timed
+timeline
+timely
+timeout
+timeouts
+timer
+timers
+times
+timespec
+timestamp
+timestamps
+timeval
+timezone
+timezones
+timing
+timings
+timo
+timothy
+tiny
+tip
+tips
+title
+titled
+titles
+tmpdir
+to
+tobias
+toc
+today
+todd
+todo
+todos
+together
+toggle
+toggled
+toggles
+tok
+token
+tokenization
+tokenize
+tokenized
+tokenizer
+tokenizes
+tokenizing
+tokens
+told
+tolerable
+tolerance
+tolerant
+tolerate
+tom
+tomas
+ton
+tons
+tony
+too
+took
+tool
+toolchain
+tooling
+toolkit
+tools
+tooltip
+top
+topic
+topics
+toplevel
+topmost
+topological
+topologically
+topology
+tops
+tos
+toss
+total
+totally
+totals
+touch
+touched
+touching
+tour
+toward
+towards
+trace
+traceback
+tracebacks
+traced
+tracer
+traces
+tracing
+track
+tracked
+tracker
+tracking
+tracks
+trade
+trading
+tradition
+traditional
+traditionally
+traffic
+trail
+trailer
+trailers
+trailing
+training
+traits
+trampoline
+trampolines
+trans
+transaction
+transactions
+transcode
+transcoding
+transfer
+transferred
+transferring
+transfers
+transform
+transformation
+transformations
+transformed
+transformer
+transforming
+transforms
+transient
+transit
+transition
+transitional
+transitions
+transitive
+translate
+translated
+translates
+translating
+translation
+translations
+translator
+translators
+transmission
+transmit
+transmits
+transmitted
+transparency
+transparent
+transparently
+transport
+transports
+transpose
+trap
+trapped
+trapping
+traps
+trash
+travel
+traversable
+traversal
+traverse
+traversed
+traverses
+traversing
+travis
+treat
+treated
+treating
+treatment
+treats
+tree
+trees
+tri
+trial
+trials
+triangular
+trick
+trickier
+tricks
+tricky
+trie
+tried
+tries
+trig
+trigger
+triggered
+triggering
+triggers
+trim
+trimmed
+trimming
+trims
+trio
+trip
+triple
+triplet
+triplets
+tripped
+trivial
+trivially
+trouble
+troubleshooting
+troublesome
+true
+truecolor
+truly
+truncate
+truncated
+truncates
+truncating
+truncation
+trust
+trusted
+trusting
+trusts
+truth
+truthy
+try
+trying
+tspecials
+tty
+tue
+tuesday
+tune
+tuned
+tuning
+tunnel
+tunneling
+tuple
+tuples
+turkish
+turn
+turned
+turning
+turns
+tutorial
+tweak
+tweaked
+tweaking
+tweaks
+twelve
+twice
+twist
+twisted
+twitter
+two
+tying
+typ
+type
+typecheck
+typechecker
+typechecking
+typed
+typedef
+typedefs
+typemap
+typename
+types
+typeset
+typical
+typically
+typing
+typo
+typographical
+typos
+tzdata
+tzinfo
+ubuntu
+udp
+ugly
+uid
+uint
+ukasz
+ulimit
+ulp
+ultimate
+ultimately
+umask
+unable
+unacceptable
+unadorned
+unaffected
+unaligned
+unallocated
+unaltered
+unambiguous
+unambiguously
+uname
+unary
+unassigned
+unattended
+unauthenticated
+unauthorized
+unavailable
+unavoidable
+unaware
+unbalanced
+unbind
+unblock
+unblocked
+unblocking
+unblocks
+unbound
+unbounded
+unbuffered
+uncaught
+uncertain
+unchanged
+unchecked
+unclear
+unclosed
+uncomment
+uncommitted
+uncommon
+uncompress
+uncompressed
+uncompresses
+uncompressing
+unconditional
+unconditionally
+unconnected
+unconstrained
+unconsumed
+uncontrolled
+undeclared
+undecoded
+undef
+undefine
+undefined
+undefines
+under
+underflow
+underflows
+undergo
+underlies
+underline
+underlined
+underlying
+underneath
+underscore
+underscored
+underscores
+understand
+understanding
+understands
+understood
+underway
+undesirable
+undetected
+undetermined
+undo
+undocumented
+undoes
+undoing
+undone
+unencoded
+unencrypted
+unequal
+unescape
+unescaped
+unexpand
+unexpanded
+unexpected
+unexpectedly
+unexported
+unfamiliar
+unfinished
+unforeseen
+unformatted
+unfortunate
+unfortunately
+ungrouped
+unhandled
+unhappy
+unhashable
+unicode
+unification
+unified
+unifies
+uniform
+uniformly
+unify
+unifying
+unimplemented
+unimportant
+unindent
+uninformative
+uninitialized
+uninstall
+uninstallation
+uninstalled
+uninstalling
+unintended
+unintentional
+unintentionally
+uninterpreted
+unintuitive
+union
+unions
+uniq
+unique
+uniquely
+uniqueness
+unistd
+unit
+united
+units
+unittest
+unittests
+universal
+universally
+universe
+university
+unix
+unixes
+unknown
+unlabeled
+unless
+unlike
+unlikely
+unlimited
+unlink
+unlinked
+unlinking
+unload
+unloaded
+unloading
+unlock
+unlocked
+unlocking
+unlocks
+unlucky
+unmap
+unmapped
+unmark
+unmarked
+unmarshal
+unmarshalling
+unmasked
+unmatched
+unmodified
+unnamed
+unnatural
+unnecessarily
+unnecessary
+unneeded
+unnormalized
+unnoticed
+unofficial
+unordered
+unpack
+unpacked
+unpacking
+unpacks
+unpadded
+unparenthesized
+unparsable
+unparsed
+unpinned
+unpleasant
+unportable
+unpredictable
+unprintable
+unprocessed
+unqualified
+unquote
+unquoted
+unquoting
+unreachable
+unread
+unreadable
+unrecognised
+unrecognized
+unrecoverable
+unref
+unreferenced
+unregister
+unregistered
+unregisters
+unrelated
+unreleased
+unreliable
+unreserved
+unresolved
+unresponsive
+unrestricted
+unsafe
+unset
+unsetenv
+unsets
+unsetting
+unshift
+unsigned
+unsorted
+unspecified
+unsplit
+unstable
+unstructured
+unsuccessful
+unsuitable
+unsupported
+unsure
+untagged
+unterminated
+untested
+until
+untouched
+untranslated
+untrusted
+unusable
+unused
+unusual
+unversioned
+unwanted
+unwind
+unwinding
+unwise
+unwrap
+unwrapped
+unwrapping
+unwritten
+unzip
+unzipped
+up
+upcoming
+update
+updated
+updater
+updates
+updating
+upfront
+upgrade
+upgraded
+upgrades
+upgrading
+upload
+uploaded
+uploading
+uploads
+upon
+upper
+uppercase
+uppercased
+upset
+upstream
+upward
+upwards
+urban
+urgency
+urgent
+uri
+url
+urlencoded
+urls
+urn
+us
+usability
+usable
+usage
+usages
+use
+usec
+used
+useful
+usefulness
+useless
+uselessly
+user
+userdata
+userid
+userinfo
+username
+usernames
+users
+uses
+using
+usleep
+usr
+ustar
+usual
+usually
+utc
+utf
+util
+utilities
+utility
+utilize
+utilized
+utilizes
+utilizing
+utils
+utime
+utterly
+uuencoded
+uuid
+vagaries
+vague
+vaguely
+val
+valid
+validate
+validated
+validates
+validating
+validation
+validations
+validator
+validators
+validity
+validly
+valids
+valign
+vals
+valuable
+value
+valued
+values
+van
+vanilla
+vanishes
+var
+varargs
+variable
+variables
+variably
+variadic
+variance
+variant
+variants
+variation
+variations
+varied
+varies
+variety
+various
+varname
+vars
+vary
+varying
+vast
+vastly
+vec
+vector
+vectors
+vehicle
+vendor
+vendored
+vendoring
+vendors
+venue
+venv
+ver
+vera
+verb
+verbatim
+verbose
+verbosity
+verbs
+verification
+verifications
+verified
+verifier
+verifiers
+verifies
+verify
+verifying
+versa
+version
+versioned
+versioning
+versions
+versus
+vertex
+vertical
+vertically
+vertices
+very
+vestige
+vetted
+vfork
+via
+viable
+vice
+victim
+victor
+video
+vietnamese
+view
+viewed
+viewer
+viewing
+viewport
+views
+vim
+vinay
+vincent
+violate
+violated
+violates
+violation
+violations
+virtual
+virtualenv
+virtually
+visibility
+visible
+visit
+visited
+visiting
+visitor
+visits
+vista
+visual
+visualize
+visually
+vital
+vocabulary
+voice
+void
+vol
+volume
+volumes
+voluntary
+von
+vote
+votes
+voucher
+vswhere
+vtype
+vulnerabilities
+vulnerability
+vulnerable
+wait
+waited
+waiter
+waiters
+waiting
+waitpid
+waits
+wake
+wakes
+wakeup
+wakeups
+waking
+walk
+walked
+walker
+walking
+walks
+wall
+wallet
+wang
+want
+wanted
+wanting
+wants
+ward
+warehouse
+warm
+warmup
+warn
+warned
+warning
+warnings
+warns
+warrant
+warranted
+warrants
+warranty
+warsaw
+was
+wasmtime
+wasn
+wasn't
+waste
+wasteful
+wastes
+wasting
+watch
+watchdog
+watcher
+watchers
+watches
+watson
+way
+wayne
+ways
+wday
+we
+we're
+weak
+weaker
+weakly
+weaknesses
+weakref
+web
+webhook
+website
+wed
+wedge
+wednesday
+week
+weekday
+weekly
+weeks
+weight
+weighted
+weighting
+weights
+weird
+weirdly
+welcome
+well
+went
+were
+weren
+weren't
+west
+what
+whatever
+whatsoever
+wheel
+wheels
+when
+whence
+whenever
+where
+whereas
+whereby
+wherein
+wherever
+whether
+which
+whichever
+while
+whilst
+whistles
+white
+whitelist
+whitelisted
+whitelisting
+whitespace
+whitespaces
+who
+whoever
+whole
+whom
+whoops
+whose
+why
+wide
+widely
+widening
+wider
+widest
+widget
+width
+widths
+wiki
+wikipedia
+wild
+wildcard
+wildcards
+will
+williams
+willing
+wilson
+win
+wind
+window
+windows
+winds
+winner
+winning
+wins
+winsock
+wipe
+wiped
+wire
+wise
+wisely
+wish
+wishes
+wishing
+with
+withdrawal
+within
+without
+woff
+woken
+wolfgang
+won
+won't
+wonder
+wonky
+word
+wording
+words
+work
+workaround
+workarounds
+worked
+worker
+workers
+workflow
+workflows
+workhorse
+working
+workloads
+works
+workspace
+world
+worlds
+worry
+worrying
+worse
+worst
+worth
+worthwhile
+would
+wouldn
+wouldn't
+wrap
+wrapped
+wrapper
+wrappers
+wrapping
+wraps
+writable
+write
+writeable
+writelines
+writer
+writers
+writes
+writing
+written
+wrong
+wrongly
+wrote
+xavier
+xcode
+xcrun
+xflags
+xinclude
+xor
+xorg
+xpath
+xref
+xterm
+xyz
+xyzzy
+yahoo
+yamaguchi
+yaml
+yday
+yeah
+year
+yearly
+years
+yellow
+yes
+yet
+yield
+yielded
+yielding
+yields
+york
+you
+you're
+young
+younger
+your
+yours
+yourself
+yuval
+zap
+zero
+zeroed
+zeroes
+zeroing
+zeros
+zeroth
+zig
+zip
+zipfile
+zips
+zlib
+zombie
+zombies
+zone
+zoneinfo
+zones
+zoo
+`
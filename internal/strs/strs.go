@@ -26,6 +26,7 @@
 package strs
 
 import (
+	"path"
 	"sort"
 	"strings"
 	"unicode"
@@ -236,6 +237,46 @@ func IsUppercase(s string) bool {
 	return strings.ToUpper(s) == s
 }
 
+// MatchSlashGlob returns true if the slash-separated path s matches the
+// slash-separated glob pattern.
+//
+// Each element of the pattern is matched with path.Match against one
+// element of s, except for "**", which matches zero or more elements.
+// An error is returned if the pattern is malformed.
+func MatchSlashGlob(pattern string, s string) (bool, error) {
+	patternElements := strings.Split(pattern, "/")
+	for _, patternElement := range patternElements {
+		if _, err := path.Match(patternElement, ""); err != nil {
+			return false, err
+		}
+	}
+	return matchSlashGlobElements(patternElements, strings.Split(s, "/")), nil
+}
+
+// matchSlashGlobElements expects the pattern elements to be valid.
+func matchSlashGlobElements(patternElements []string, elements []string) bool {
+	for len(patternElements) > 0 {
+		patternElement := patternElements[0]
+		patternElements = patternElements[1:]
+		if patternElement == "**" {
+			for i := 0; i <= len(elements); i++ {
+				if matchSlashGlobElements(patternElements, elements[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(elements) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(patternElement, elements[0]); !matched {
+			return false
+		}
+		elements = elements[1:]
+	}
+	return len(elements) == 0
+}
+
 // isSnake returns true if s only contains letters, digits, and/or underscores.
 // s MUST NOT begin or end with an underscore.
 func isSnake(s string) bool {
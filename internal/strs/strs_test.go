@@ -191,3 +191,32 @@ func TestIntersection(t *testing.T) {
 	assert.Equal(t, []string{"1", "2"}, Intersection([]string{"1", "2", "", "3"}, []string{"1", "5", "2"}))
 	assert.Equal(t, []string{"1", "2"}, Intersection([]string{"1", "2", "", "3"}, []string{"1", "5", "", "2"}))
 }
+
+func TestMatchSlashGlob(t *testing.T) {
+	for _, c := range []struct {
+		pattern  string
+		s        string
+		expected bool
+	}{
+		{"foo", "foo", true},
+		{"foo", "bar", false},
+		{"foo/*.proto", "foo/bar.proto", true},
+		{"foo/*.proto", "foo/bar/baz.proto", false},
+		{"foo/**", "foo", true},
+		{"foo/**", "foo/bar/baz.proto", true},
+		{"foo/**", "foobar/baz.proto", false},
+		{"**/internal/**", "internal", true},
+		{"**/internal/**", "foo/internal/bar.proto", true},
+		{"**/internal/**", "foo/bar.proto", false},
+		{"**/*.proto", "foo/bar.proto", true},
+		{"foo/**/v1", "foo/v1", true},
+		{"foo/**/v1", "foo/bar/baz/v1", true},
+		{"foo/**/v1", "foo/bar/v2", false},
+	} {
+		matched, err := MatchSlashGlob(c.pattern, c.s)
+		assert.NoError(t, err)
+		assert.Equal(t, c.expected, matched, "%s %s", c.pattern, c.s)
+	}
+	_, err := MatchSlashGlob("foo/[", "bar")
+	assert.Error(t, err)
+}
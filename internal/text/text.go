@@ -40,6 +40,19 @@ const (
 	FailureFieldID
 	// FailureFieldMessage references the Message field of a Failure.
 	FailureFieldMessage
+	// FailureFieldSeverity references the Severity field of a Failure.
+	FailureFieldSeverity
+)
+
+const (
+	// SeverityError is the error severity.
+	//
+	// Failures with no severity set are treated as errors.
+	SeverityError = "error"
+	// SeverityWarning is the warning severity.
+	SeverityWarning = "warning"
+	// SeverityInfo is the info severity.
+	SeverityInfo = "info"
 )
 
 var (
@@ -57,6 +70,7 @@ var (
 		FailureFieldColumn:   "column",
 		FailureFieldID:       "id",
 		FailureFieldMessage:  "message",
+		FailureFieldSeverity: "severity",
 	}
 	_stringToFailureField = map[string]FailureField{
 		"filename": FailureFieldFilename,
@@ -64,6 +78,13 @@ var (
 		"column":   FailureFieldColumn,
 		"id":       FailureFieldID,
 		"message":  FailureFieldMessage,
+		"severity": FailureFieldSeverity,
+	}
+
+	_severityToLevel = map[string]int{
+		SeverityInfo:    0,
+		SeverityWarning: 1,
+		SeverityError:   2,
 	}
 )
 
@@ -106,6 +127,20 @@ func ParseColonSeparatedFailureFields(s string) ([]FailureField, error) {
 	return failureFields, nil
 }
 
+// ParseSeverity parses the severity from the given string.
+//
+// Input is case-insensitive. If the string is empty, SeverityError will be returned.
+func ParseSeverity(s string) (string, error) {
+	if s == "" {
+		return SeverityError, nil
+	}
+	severity := strings.ToLower(s)
+	if _, ok := _severityToLevel[severity]; !ok {
+		return "", fmt.Errorf("could not parse %s to a severity, must be one of error, warning, info", s)
+	}
+	return severity, nil
+}
+
 // Failure is a failure with a position in text.
 type Failure struct {
 	Filename string `json:"filename,omitempty"`
@@ -113,6 +148,15 @@ type Failure struct {
 	Column   int    `json:"column,omitempty"`
 	LintID   string `json:"lint_id,omitempty"`
 	Message  string `json:"message,omitempty"`
+	Severity string `json:"severity,omitempty"`
+}
+
+// HasSeverityAtLeast returns true if the severity of the Failure is greater than
+// or equal to the given severity.
+//
+// An empty severity on either side is treated as SeverityError.
+func (f *Failure) HasSeverityAtLeast(severity string) bool {
+	return severityLevel(f.Severity) >= severityLevel(severity)
 }
 
 // FailureWriter is a writer that Failure.Println can accept.
@@ -177,6 +221,15 @@ func (f *Failure) Fprintln(writer FailureWriter, fields ...FailureField) error {
 			} else {
 				printColon = false
 			}
+		case FailureFieldSeverity:
+			severity := f.Severity
+			if severity == "" {
+				severity = SeverityError
+			}
+			if _, err := writer.WriteString(severity); err != nil {
+				return err
+			}
+			written = true
 		default:
 			return fmt.Errorf("unknown FailureField: %v", field)
 		}
@@ -234,6 +287,18 @@ func NewFailuref(position scanner.Position, lintID string, format string, args .
 	}
 }
 
+// CountFailuresWithSeverityAtLeast returns the number of Failures that have
+// a severity greater than or equal to the given severity.
+func CountFailuresWithSeverityAtLeast(failures []*Failure, severity string) int {
+	count := 0
+	for _, failure := range failures {
+		if failure != nil && failure.HasSeverityAtLeast(severity) {
+			count++
+		}
+	}
+	return count
+}
+
 // SortFailures sorts the Failures, by filename, line, column, id, message.
 func SortFailures(failures []*Failure) {
 	sort.Stable(sortFailures(failures))
@@ -282,3 +347,10 @@ func (f sortFailures) Less(i int, j int) bool {
 	}
 	return false
 }
+
+func severityLevel(severity string) int {
+	if level, ok := _severityToLevel[severity]; ok {
+		return level
+	}
+	return _severityToLevel[SeverityError]
+}
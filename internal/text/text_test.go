@@ -43,6 +43,16 @@ func TestFailureFprintln(t *testing.T) {
 		FailureFieldFilename,
 		FailureFieldID,
 	)
+	testFailureFprintln(t, "error:BAR", newTestFailure("", 0, 2, "BAR", "hello"),
+		FailureFieldSeverity,
+		FailureFieldID,
+	)
+	failure := newTestFailure("", 0, 2, "BAR", "hello")
+	failure.Severity = SeverityWarning
+	testFailureFprintln(t, "warning:BAR", failure,
+		FailureFieldSeverity,
+		FailureFieldID,
+	)
 }
 
 func testFailureFprintln(t *testing.T, expected string, failure *Failure, failureFields ...FailureField) {
@@ -71,6 +81,38 @@ func testParseColonSeparatedFailureFields(t *testing.T, input string, expectErro
 	}
 }
 
+func TestParseSeverity(t *testing.T) {
+	testParseSeverity(t, "", false, SeverityError)
+	testParseSeverity(t, "error", false, SeverityError)
+	testParseSeverity(t, "WARNING", false, SeverityWarning)
+	testParseSeverity(t, "info", false, SeverityInfo)
+	testParseSeverity(t, "fatal", true, "")
+}
+
+func testParseSeverity(t *testing.T, input string, expectError bool, expectedSeverity string) {
+	severity, err := ParseSeverity(input)
+	if expectError {
+		assert.Error(t, err)
+	} else {
+		assert.NoError(t, err)
+		assert.Equal(t, expectedSeverity, severity)
+	}
+}
+
+func TestCountFailuresWithSeverityAtLeast(t *testing.T) {
+	failures := []*Failure{
+		nil,
+		{Message: "one"},
+		{Message: "two", Severity: SeverityError},
+		{Message: "three", Severity: SeverityWarning},
+		{Message: "four", Severity: SeverityInfo},
+	}
+	assert.Equal(t, 2, CountFailuresWithSeverityAtLeast(failures, SeverityError))
+	assert.Equal(t, 2, CountFailuresWithSeverityAtLeast(failures, ""))
+	assert.Equal(t, 3, CountFailuresWithSeverityAtLeast(failures, SeverityWarning))
+	assert.Equal(t, 4, CountFailuresWithSeverityAtLeast(failures, SeverityInfo))
+}
+
 func TestSortFailures(t *testing.T) {
 	failures := []*Failure{
 		nil,
@@ -134,5 +176,5 @@ func TestSortFailures(t *testing.T) {
 }
 
 func newTestFailure(filename string, line int, column int, id string, message string) *Failure {
-	return NewFailuref(scanner.Position{Filename: filename, Line: line, Column: column}, id, message)
+	return NewFailuref(scanner.Position{Filename: filename, Line: line, Column: column}, id, "%s", message)
 }
@@ -42,6 +42,64 @@ var (
 		"google/protobuf/wrappers.proto":        struct{}{},
 	}
 
+	// TypeNameToFilename is a map from the fully-qualified name of each
+	// top-level message and enum in the Google Well-Known Types to the
+	// filename it is defined in.
+	TypeNameToFilename = map[string]string{
+		"google.protobuf.Any":                            "google/protobuf/any.proto",
+		"google.protobuf.Api":                            "google/protobuf/api.proto",
+		"google.protobuf.BoolValue":                      "google/protobuf/wrappers.proto",
+		"google.protobuf.BytesValue":                     "google/protobuf/wrappers.proto",
+		"google.protobuf.DescriptorProto":                "google/protobuf/descriptor.proto",
+		"google.protobuf.DoubleValue":                    "google/protobuf/wrappers.proto",
+		"google.protobuf.Duration":                       "google/protobuf/duration.proto",
+		"google.protobuf.Empty":                          "google/protobuf/empty.proto",
+		"google.protobuf.Enum":                           "google/protobuf/type.proto",
+		"google.protobuf.EnumDescriptorProto":            "google/protobuf/descriptor.proto",
+		"google.protobuf.EnumOptions":                    "google/protobuf/descriptor.proto",
+		"google.protobuf.EnumValue":                      "google/protobuf/type.proto",
+		"google.protobuf.EnumValueDescriptorProto":       "google/protobuf/descriptor.proto",
+		"google.protobuf.EnumValueOptions":               "google/protobuf/descriptor.proto",
+		"google.protobuf.ExtensionRangeOptions":          "google/protobuf/descriptor.proto",
+		"google.protobuf.Field":                          "google/protobuf/type.proto",
+		"google.protobuf.FieldDescriptorProto":           "google/protobuf/descriptor.proto",
+		"google.protobuf.FieldMask":                      "google/protobuf/field_mask.proto",
+		"google.protobuf.FieldOptions":                   "google/protobuf/descriptor.proto",
+		"google.protobuf.FileDescriptorProto":            "google/protobuf/descriptor.proto",
+		"google.protobuf.FileDescriptorSet":              "google/protobuf/descriptor.proto",
+		"google.protobuf.FileOptions":                    "google/protobuf/descriptor.proto",
+		"google.protobuf.FloatValue":                     "google/protobuf/wrappers.proto",
+		"google.protobuf.GeneratedCodeInfo":              "google/protobuf/descriptor.proto",
+		"google.protobuf.Int32Value":                     "google/protobuf/wrappers.proto",
+		"google.protobuf.Int64Value":                     "google/protobuf/wrappers.proto",
+		"google.protobuf.ListValue":                      "google/protobuf/struct.proto",
+		"google.protobuf.MessageOptions":                 "google/protobuf/descriptor.proto",
+		"google.protobuf.Method":                         "google/protobuf/api.proto",
+		"google.protobuf.MethodDescriptorProto":          "google/protobuf/descriptor.proto",
+		"google.protobuf.MethodOptions":                  "google/protobuf/descriptor.proto",
+		"google.protobuf.Mixin":                          "google/protobuf/api.proto",
+		"google.protobuf.NullValue":                      "google/protobuf/struct.proto",
+		"google.protobuf.OneofDescriptorProto":           "google/protobuf/descriptor.proto",
+		"google.protobuf.OneofOptions":                   "google/protobuf/descriptor.proto",
+		"google.protobuf.Option":                         "google/protobuf/type.proto",
+		"google.protobuf.ServiceDescriptorProto":         "google/protobuf/descriptor.proto",
+		"google.protobuf.ServiceOptions":                 "google/protobuf/descriptor.proto",
+		"google.protobuf.SourceCodeInfo":                 "google/protobuf/descriptor.proto",
+		"google.protobuf.SourceContext":                  "google/protobuf/source_context.proto",
+		"google.protobuf.StringValue":                    "google/protobuf/wrappers.proto",
+		"google.protobuf.Struct":                         "google/protobuf/struct.proto",
+		"google.protobuf.Syntax":                         "google/protobuf/type.proto",
+		"google.protobuf.Timestamp":                      "google/protobuf/timestamp.proto",
+		"google.protobuf.Type":                           "google/protobuf/type.proto",
+		"google.protobuf.UInt32Value":                    "google/protobuf/wrappers.proto",
+		"google.protobuf.UInt64Value":                    "google/protobuf/wrappers.proto",
+		"google.protobuf.UninterpretedOption":            "google/protobuf/descriptor.proto",
+		"google.protobuf.Value":                          "google/protobuf/struct.proto",
+		"google.protobuf.compiler.CodeGeneratorRequest":  "google/protobuf/compiler/plugin.proto",
+		"google.protobuf.compiler.CodeGeneratorResponse": "google/protobuf/compiler/plugin.proto",
+		"google.protobuf.compiler.Version":               "google/protobuf/compiler/plugin.proto",
+	}
+
 	// FilenameToGoModifierMap is a map from filename to package for github.com/golang/protobuf.
 	FilenameToGoModifierMap = map[string]string{
 		"google/protobuf/any.proto":             "github.com/golang/protobuf/ptypes/any",